@@ -0,0 +1,433 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/project-go-sdk/common"
+	"github.com/go-openapi/strfmt"
+)
+
+// DefaultBulkMaxParallel is the number of configurations that a bulk operation processes at the same time when
+// BulkOptions.MaxParallel is not set.
+const DefaultBulkMaxParallel = 4
+
+// Constants associated with the BulkItemResult.Status property.
+// The outcome of the operation on the configuration.
+const (
+	BulkItemResult_Status_Failed    = "failed"
+	BulkItemResult_Status_Skipped   = "skipped"
+	BulkItemResult_Status_Succeeded = "succeeded"
+)
+
+// ConfigRef : A configuration of a project.
+type ConfigRef struct {
+	// The unique project ID.
+	ProjectID string `json:"project_id" validate:"required"`
+
+	// The unique configuration ID.
+	ConfigID string `json:"config_id" validate:"required"`
+}
+
+// BulkOptions : The options of BulkValidate, BulkDeploy, BulkUndeploy and BulkDeleteConfigs.
+type BulkOptions struct {
+	// The configurations to process, in order.
+	Configs []ConfigRef `json:"configs" validate:"required,min=1,dive"`
+
+	// The maximum number of configurations that are processed at the same time. Defaults to DefaultBulkMaxParallel.
+	MaxParallel int `json:"max_parallel,omitempty"`
+
+	// The maximum number of validate, deploy, undeploy or delete requests per second, across the configurations. A
+	// zero value does not limit the rate. The polls of Wait are not limited. The requests are also limited by the
+	// rate limit of the client, if one is set with SetRateLimit, so the lower of the two rates holds.
+	RateLimit float64 `json:"rate_limit,omitempty"`
+
+	// The share of the configurations, between 0 and 1, that may fail before the operation stops. Once more than this
+	// share of all the configurations has failed, the configurations that have not started are skipped; those that
+	// have started are finished. A zero value never stops the operation.
+	MaxFailureRatio float64 `json:"max_failure_ratio,omitempty"`
+
+	// Whether to wait for each validation, deployment or undeployment to finish, with WaitForConfigState, before the
+	// configuration succeeds. A configuration that reaches a failure state fails. Deletions do not wait.
+	Wait bool `json:"wait,omitempty"`
+
+	// The delay between the first polls of a configuration state. See WaitForConfigStateOptions.PollInterval.
+	PollInterval time.Duration `json:"poll_interval,omitempty"`
+
+	// The upper bound for the delay between polls. See WaitForConfigStateOptions.MaxPollInterval.
+	MaxPollInterval time.Duration `json:"max_poll_interval,omitempty"`
+
+	// The factor by which the delay between polls grows. See WaitForConfigStateOptions.BackoffFactor.
+	BackoffFactor float64 `json:"backoff_factor,omitempty"`
+
+	// The maximum time to wait for each configuration. A zero value waits until the context is done.
+	ItemTimeout time.Duration `json:"item_timeout,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewBulkOptions : Instantiate BulkOptions
+func (*ProjectV1) NewBulkOptions(configs []ConfigRef) *BulkOptions {
+	return &BulkOptions{
+		Configs: configs,
+	}
+}
+
+// SetConfigs : Allow user to set Configs
+func (_options *BulkOptions) SetConfigs(configs []ConfigRef) *BulkOptions {
+	_options.Configs = configs
+	return _options
+}
+
+// SetMaxParallel : Allow user to set MaxParallel
+func (_options *BulkOptions) SetMaxParallel(maxParallel int) *BulkOptions {
+	_options.MaxParallel = maxParallel
+	return _options
+}
+
+// SetRateLimit : Allow user to set RateLimit
+func (_options *BulkOptions) SetRateLimit(rateLimit float64) *BulkOptions {
+	_options.RateLimit = rateLimit
+	return _options
+}
+
+// SetMaxFailureRatio : Allow user to set MaxFailureRatio
+func (_options *BulkOptions) SetMaxFailureRatio(maxFailureRatio float64) *BulkOptions {
+	_options.MaxFailureRatio = maxFailureRatio
+	return _options
+}
+
+// SetWait : Allow user to set Wait
+func (_options *BulkOptions) SetWait(wait bool) *BulkOptions {
+	_options.Wait = wait
+	return _options
+}
+
+// SetPollInterval : Allow user to set PollInterval
+func (_options *BulkOptions) SetPollInterval(pollInterval time.Duration) *BulkOptions {
+	_options.PollInterval = pollInterval
+	return _options
+}
+
+// SetMaxPollInterval : Allow user to set MaxPollInterval
+func (_options *BulkOptions) SetMaxPollInterval(maxPollInterval time.Duration) *BulkOptions {
+	_options.MaxPollInterval = maxPollInterval
+	return _options
+}
+
+// SetBackoffFactor : Allow user to set BackoffFactor
+func (_options *BulkOptions) SetBackoffFactor(backoffFactor float64) *BulkOptions {
+	_options.BackoffFactor = backoffFactor
+	return _options
+}
+
+// SetItemTimeout : Allow user to set ItemTimeout
+func (_options *BulkOptions) SetItemTimeout(itemTimeout time.Duration) *BulkOptions {
+	_options.ItemTimeout = itemTimeout
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *BulkOptions) SetHeaders(param map[string]string) *BulkOptions {
+	options.Headers = param
+	return options
+}
+
+// BulkItemResult : The outcome of a bulk operation on a configuration.
+type BulkItemResult struct {
+	// The unique project ID.
+	ProjectID string `json:"project_id"`
+
+	// The unique configuration ID.
+	ConfigID string `json:"config_id"`
+
+	// The outcome of the operation on the configuration.
+	Status string `json:"status"`
+
+	// The version that the validate, deploy or undeploy request returned.
+	Version *ProjectConfigVersion `json:"version,omitempty"`
+
+	// The configuration as it was last retrieved while waiting.
+	Config *ProjectConfig `json:"config,omitempty"`
+
+	// The reason the operation failed or was skipped.
+	Err error `json:"-"`
+
+	// When the operation on the configuration started.
+	StartedAt *strfmt.DateTime `json:"started_at,omitempty"`
+
+	// When the operation on the configuration finished.
+	FinishedAt *strfmt.DateTime `json:"finished_at,omitempty"`
+}
+
+// BulkResult : The outcome of a bulk operation.
+type BulkResult struct {
+	// The results of the configurations, in the order of the options.
+	Items []BulkItemResult `json:"items"`
+
+	// Whether the operation stopped because too many configurations failed.
+	Stopped bool `json:"stopped"`
+}
+
+// Succeeded returns the number of configurations that succeeded.
+func (result *BulkResult) Succeeded() int {
+	succeeded := 0
+	for _, item := range result.Items {
+		if item.Status == BulkItemResult_Status_Succeeded {
+			succeeded++
+		}
+	}
+	return succeeded
+}
+
+// Failed returns the results of the configurations that failed or were skipped.
+func (result *BulkResult) Failed() []BulkItemResult {
+	var failed []BulkItemResult
+	for _, item := range result.Items {
+		if item.Status != BulkItemResult_Status_Succeeded {
+			failed = append(failed, item)
+		}
+	}
+	return failed
+}
+
+// BulkValidate : Validate configurations
+// Run ValidateConfig on each configuration, up to MaxParallel at the same time, and wait for the validations to finish
+// if the Wait option is set. The returned result reports the outcome of every configuration; an error is also
+// returned if any configuration did not succeed.
+func (project *ProjectV1) BulkValidate(bulkOptions *BulkOptions) (result *BulkResult, err error) {
+	result, err = project.BulkValidateWithContext(context.Background(), bulkOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// BulkValidateWithContext is an alternate form of the BulkValidate method which supports a Context parameter
+func (project *ProjectV1) BulkValidateWithContext(ctx context.Context, bulkOptions *BulkOptions) (result *BulkResult, err error) {
+	return project.runBulk(ctx, bulkOptions, "validated", func(ctx context.Context, item *BulkItemResult, headers map[string]string) (err error) {
+		item.Version, _, err = project.ValidateConfigWithContext(ctx, &ValidateConfigOptions{
+			ProjectID: core.StringPtr(item.ProjectID),
+			ID:        core.StringPtr(item.ConfigID),
+			Headers:   headers,
+		})
+		return
+	}, []string{ProjectConfig_State_Validated})
+}
+
+// BulkDeploy : Deploy configurations
+// Run DeployConfig on each configuration, up to MaxParallel at the same time, and wait for the deployments to finish
+// if the Wait option is set. The returned result reports the outcome of every configuration; an error is also
+// returned if any configuration did not succeed.
+func (project *ProjectV1) BulkDeploy(bulkOptions *BulkOptions) (result *BulkResult, err error) {
+	result, err = project.BulkDeployWithContext(context.Background(), bulkOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// BulkDeployWithContext is an alternate form of the BulkDeploy method which supports a Context parameter
+func (project *ProjectV1) BulkDeployWithContext(ctx context.Context, bulkOptions *BulkOptions) (result *BulkResult, err error) {
+	return project.bulkDeploy(ctx, project, bulkOptions)
+}
+
+// bulkDeploy deploys the configurations of the options through the DeployConfigWithContext method of actions.
+func (project *ProjectV1) bulkDeploy(ctx context.Context, actions configActions, bulkOptions *BulkOptions) (result *BulkResult, err error) {
+	return project.runBulk(ctx, bulkOptions, "deployed", func(ctx context.Context, item *BulkItemResult, headers map[string]string) (err error) {
+		item.Version, _, err = actions.DeployConfigWithContext(ctx, &DeployConfigOptions{
+			ProjectID: core.StringPtr(item.ProjectID),
+			ID:        core.StringPtr(item.ConfigID),
+			Headers:   headers,
+		})
+		return
+	}, []string{ProjectConfig_State_Deployed})
+}
+
+// BulkUndeploy : Undeploy configurations
+// Run UndeployConfig on each configuration, up to MaxParallel at the same time, and wait for the undeployments to
+// finish if the Wait option is set. The returned result reports the outcome of every configuration; an error is also
+// returned if any configuration did not succeed.
+func (project *ProjectV1) BulkUndeploy(bulkOptions *BulkOptions) (result *BulkResult, err error) {
+	result, err = project.BulkUndeployWithContext(context.Background(), bulkOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// BulkUndeployWithContext is an alternate form of the BulkUndeploy method which supports a Context parameter
+func (project *ProjectV1) BulkUndeployWithContext(ctx context.Context, bulkOptions *BulkOptions) (result *BulkResult, err error) {
+	return project.bulkUndeploy(ctx, project, bulkOptions)
+}
+
+// bulkUndeploy undeploys the configurations of the options through the UndeployConfigWithContext method of actions.
+func (project *ProjectV1) bulkUndeploy(ctx context.Context, actions configActions, bulkOptions *BulkOptions) (result *BulkResult, err error) {
+	return project.runBulk(ctx, bulkOptions, "undeployed", func(ctx context.Context, item *BulkItemResult, headers map[string]string) (err error) {
+		item.Version, _, err = actions.UndeployConfigWithContext(ctx, &UndeployConfigOptions{
+			ProjectID: core.StringPtr(item.ProjectID),
+			ID:        core.StringPtr(item.ConfigID),
+			Headers:   headers,
+		})
+		return
+	}, []string{ProjectConfig_State_Approved, ProjectConfig_State_Draft})
+}
+
+// BulkDeleteConfigs : Delete configurations
+// Run DeleteConfig on each configuration, up to MaxParallel at the same time. Deployed configurations must be
+// undeployed first, such as with BulkUndeploy and the Wait option. The returned result reports the outcome of every
+// configuration; an error is also returned if any configuration did not succeed.
+func (project *ProjectV1) BulkDeleteConfigs(bulkOptions *BulkOptions) (result *BulkResult, err error) {
+	result, err = project.BulkDeleteConfigsWithContext(context.Background(), bulkOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// BulkDeleteConfigsWithContext is an alternate form of the BulkDeleteConfigs method which supports a Context parameter
+func (project *ProjectV1) BulkDeleteConfigsWithContext(ctx context.Context, bulkOptions *BulkOptions) (result *BulkResult, err error) {
+	return project.bulkDeleteConfigs(ctx, project, bulkOptions)
+}
+
+// bulkDeleteConfigs deletes the configurations of the options through the DeleteConfigWithContext method of actions.
+func (project *ProjectV1) bulkDeleteConfigs(ctx context.Context, actions configActions, bulkOptions *BulkOptions) (result *BulkResult, err error) {
+	return project.runBulk(ctx, bulkOptions, "deleted", func(ctx context.Context, item *BulkItemResult, headers map[string]string) (err error) {
+		_, _, err = actions.DeleteConfigWithContext(ctx, &DeleteConfigOptions{
+			ProjectID: core.StringPtr(item.ProjectID),
+			ID:        core.StringPtr(item.ConfigID),
+			Headers:   headers,
+		})
+		return
+	}, nil)
+}
+
+// bulkAction sends the request of a bulk operation for a configuration.
+type bulkAction func(ctx context.Context, item *BulkItemResult, headers map[string]string) error
+
+// runBulk runs an action on the configurations of the options. If the Wait option is set and target states are
+// specified, each configuration then waits for one of them.
+func (project *ProjectV1) runBulk(ctx context.Context, bulkOptions *BulkOptions, done string, action bulkAction, targetStates []string) (result *BulkResult, err error) {
+	err = core.ValidateNotNil(bulkOptions, "bulkOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(bulkOptions, "bulkOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	maxParallel := bulkOptions.MaxParallel
+	if maxParallel <= 0 {
+		maxParallel = DefaultBulkMaxParallel
+	}
+	// A bucket of a single token spaces the requests evenly.
+	var limiter *tokenBucket
+	if bulkOptions.RateLimit > 0 {
+		limiter = newTokenBucket(bulkOptions.RateLimit, 1)
+	}
+	result = &BulkResult{Items: make([]BulkItemResult, len(bulkOptions.Configs))}
+	for i, config := range bulkOptions.Configs {
+		result.Items[i] = BulkItemResult{ProjectID: config.ProjectID, ConfigID: config.ConfigID}
+	}
+
+	finished := make(chan *BulkItemResult)
+	next, running, failed := 0, 0, 0
+	for next < len(result.Items) || running > 0 {
+		for next < len(result.Items) && running < maxParallel && !result.Stopped && ctx.Err() == nil {
+			item := &result.Items[next]
+			next++
+			running++
+			go func() {
+				project.runBulkItem(ctx, bulkOptions, limiter, action, targetStates, item)
+				finished <- item
+			}()
+		}
+		if running == 0 {
+			break
+		}
+
+		item := <-finished
+		running--
+		if item.Status == BulkItemResult_Status_Failed {
+			failed++
+			if bulkOptions.MaxFailureRatio > 0 && float64(failed) > bulkOptions.MaxFailureRatio*float64(len(result.Items)) {
+				result.Stopped = true
+			}
+		}
+	}
+
+	notDone := 0
+	for i := range result.Items {
+		item := &result.Items[i]
+		if item.Status == "" {
+			item.Status = BulkItemResult_Status_Skipped
+			if result.Stopped {
+				item.Err = core.SDKErrorf(nil, fmt.Sprintf("the operation stopped after %d configurations failed", failed), "bulk-stopped", common.GetComponentInfo())
+			} else {
+				item.Err = core.SDKErrorf(ctx.Err(), "", "bulk-interrupted", common.GetComponentInfo())
+			}
+		}
+		if item.Status != BulkItemResult_Status_Succeeded {
+			notDone++
+		}
+	}
+	if notDone > 0 {
+		err = core.SDKErrorf(nil, fmt.Sprintf("%d of %d configurations were not %s", notDone, len(result.Items), done), "bulk-items-failed", common.GetComponentInfo())
+	}
+	return
+}
+
+// runBulkItem runs an action on a configuration and sets the outcome of the item.
+func (project *ProjectV1) runBulkItem(ctx context.Context, bulkOptions *BulkOptions, limiter *tokenBucket, action bulkAction, targetStates []string, item *BulkItemResult) {
+	startedAt := strfmt.DateTime(time.Now())
+	item.StartedAt = &startedAt
+	defer func() {
+		finishedAt := strfmt.DateTime(time.Now())
+		item.FinishedAt = &finishedAt
+	}()
+
+	err := ctx.Err()
+	if err == nil && limiter != nil {
+		err = limiter.wait(ctx)
+	}
+	if err == nil {
+		err = action(ctx, item, bulkOptions.Headers)
+	}
+	if err == nil && bulkOptions.Wait && targetStates != nil {
+		item.Config, _, err = project.WaitForConfigStateWithContext(ctx, &WaitForConfigStateOptions{
+			ProjectID:       core.StringPtr(item.ProjectID),
+			ID:              core.StringPtr(item.ConfigID),
+			TargetStates:    targetStates,
+			PollInterval:    bulkOptions.PollInterval,
+			MaxPollInterval: bulkOptions.MaxPollInterval,
+			BackoffFactor:   bulkOptions.BackoffFactor,
+			Timeout:         bulkOptions.ItemTimeout,
+			Headers:         bulkOptions.Headers,
+		})
+		var stateErr *ConfigStateError
+		if errors.As(err, &stateErr) {
+			item.Config = stateErr.Config
+		}
+	}
+	if err != nil {
+		item.Status = BulkItemResult_Status_Failed
+		item.Err = err
+		return
+	}
+	item.Status = BulkItemResult_Status_Succeeded
+}
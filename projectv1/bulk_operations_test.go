@@ -0,0 +1,217 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/project-go-sdk/projectv1"
+	"github.com/IBM/project-go-sdk/projectv1fake"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Bulk operations`, func() {
+	var server *projectv1fake.Server
+	var projectService *projectv1.ProjectV1
+	var projectID string
+	var configs []projectv1.ConfigRef
+
+	stateOf := func(configID string) string {
+		config, _, err := projectService.GetConfig(projectService.NewGetConfigOptions(projectID, configID))
+		Expect(err).To(BeNil())
+		return *config.State
+	}
+	statuses := func(result *projectv1.BulkResult) []string {
+		values := []string{}
+		for _, item := range result.Items {
+			values = append(values, item.Status)
+		}
+		return values
+	}
+	newOptions := func(configs []projectv1.ConfigRef) *projectv1.BulkOptions {
+		return projectService.NewBulkOptions(configs).
+			SetWait(true).
+			SetPollInterval(time.Millisecond).
+			SetMaxPollInterval(5 * time.Millisecond)
+	}
+
+	BeforeEach(func() {
+		var err error
+		server = projectv1fake.NewServer()
+		projectService, err = server.NewProjectV1()
+		Expect(err).To(BeNil())
+		project, _, err := projectService.CreateProject(projectService.NewCreateProjectOptions(
+			&projectv1.ProjectPrototypeDefinition{Name: core.StringPtr("ephemeral")}, "us-south", "Default"))
+		Expect(err).To(BeNil())
+		projectID = *project.ID
+
+		configs = nil
+		for i := 0; i < 5; i++ {
+			config, _, err := projectService.CreateConfig(projectService.NewCreateConfigOptions(projectID,
+				&projectv1.ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype{Name: core.StringPtr(fmt.Sprintf("env-%d", i))}))
+			Expect(err).To(BeNil())
+			configs = append(configs, projectv1.ConfigRef{ProjectID: projectID, ConfigID: *config.ID})
+		}
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Validates, deploys, undeploys and deletes configurations`, func() {
+		result, err := projectService.BulkValidate(newOptions(configs).SetMaxParallel(2))
+		Expect(err).To(BeNil())
+		Expect(result.Succeeded()).To(Equal(5))
+		Expect(result.Stopped).To(BeFalse())
+		for i, item := range result.Items {
+			Expect(item.ProjectID).To(Equal(projectID))
+			Expect(item.ConfigID).To(Equal(configs[i].ConfigID))
+			Expect(*item.Version.State).To(Equal(projectv1.ProjectConfig_State_Validating))
+			Expect(*item.Config.State).To(Equal(projectv1.ProjectConfig_State_Validated))
+			Expect(item.Err).To(BeNil())
+			Expect(time.Time(*item.FinishedAt)).ToNot(BeTemporally("<", time.Time(*item.StartedAt)))
+		}
+		for _, config := range configs {
+			_, _, err = projectService.Approve(projectService.NewApproveOptions(projectID, config.ConfigID))
+			Expect(err).To(BeNil())
+		}
+
+		result, err = projectService.BulkDeploy(newOptions(configs))
+		Expect(err).To(BeNil())
+		Expect(result.Succeeded()).To(Equal(5))
+		Expect(stateOf(configs[4].ConfigID)).To(Equal(projectv1.ProjectConfig_State_Deployed))
+
+		result, err = projectService.BulkUndeploy(newOptions(configs))
+		Expect(err).To(BeNil())
+		Expect(result.Succeeded()).To(Equal(5))
+		Expect(*result.Items[0].Config.State).To(Equal(projectv1.ProjectConfig_State_Approved))
+
+		result, err = projectService.BulkDeleteConfigs(newOptions(configs))
+		Expect(err).To(BeNil())
+		Expect(result.Succeeded()).To(Equal(5))
+		Expect(result.Items[0].Version).To(BeNil())
+		Expect(result.Items[0].Config).To(BeNil())
+		list, _, err := projectService.ListConfigs(projectService.NewListConfigsOptions(projectID))
+		Expect(err).To(BeNil())
+		Expect(list.Configs).To(BeEmpty())
+	})
+	It(`Reports the configurations that fail`, func() {
+		server.FailAction(configs[1].ConfigID, projectv1fake.ActionValidate)
+		items := append([]projectv1.ConfigRef{{ProjectID: projectID, ConfigID: "unknown"}}, configs...)
+
+		result, err := projectService.BulkValidate(newOptions(items))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("2 of 6 configurations were not validated"))
+		Expect(statuses(result)).To(Equal([]string{
+			projectv1.BulkItemResult_Status_Failed,
+			projectv1.BulkItemResult_Status_Succeeded,
+			projectv1.BulkItemResult_Status_Failed,
+			projectv1.BulkItemResult_Status_Succeeded,
+			projectv1.BulkItemResult_Status_Succeeded,
+			projectv1.BulkItemResult_Status_Succeeded,
+		}))
+		Expect(result.Items[0].Version).To(BeNil())
+		Expect(result.Items[0].Err).ToNot(BeNil())
+		Expect(*result.Items[2].Config.State).To(Equal(projectv1.ProjectConfig_State_ValidatingFailed))
+		Expect(result.Failed()).To(HaveLen(2))
+	})
+	It(`Stops when too many configurations fail`, func() {
+		items := append([]projectv1.ConfigRef{
+			{ProjectID: projectID, ConfigID: "unknown-1"},
+			{ProjectID: projectID, ConfigID: "unknown-2"},
+		}, configs[:3]...)
+
+		result, err := projectService.BulkValidate(newOptions(items).SetMaxParallel(1).SetMaxFailureRatio(0.2))
+		Expect(err).ToNot(BeNil())
+		Expect(result.Stopped).To(BeTrue())
+		Expect(statuses(result)).To(Equal([]string{
+			projectv1.BulkItemResult_Status_Failed,
+			projectv1.BulkItemResult_Status_Failed,
+			projectv1.BulkItemResult_Status_Skipped,
+			projectv1.BulkItemResult_Status_Skipped,
+			projectv1.BulkItemResult_Status_Skipped,
+		}))
+		Expect(result.Items[2].Err.Error()).To(ContainSubstring("the operation stopped after 2 configurations failed"))
+		Expect(result.Items[2].StartedAt).To(BeNil())
+		data, err := json.Marshal(result.Items[2])
+		Expect(err).To(BeNil())
+		Expect(string(data)).ToNot(ContainSubstring("started_at"))
+		Expect(stateOf(configs[0].ConfigID)).To(Equal(projectv1.ProjectConfig_State_Draft))
+	})
+	It(`Limits the rate of the requests`, func() {
+		start := time.Now()
+		_, err := projectService.BulkValidate(projectService.NewBulkOptions(configs[:3]).SetRateLimit(20))
+		Expect(err).To(BeNil())
+		Expect(time.Since(start)).To(BeNumerically(">=", 100*time.Millisecond))
+	})
+	It(`Is limited by the rate limit of the client too`, func() {
+		projectService.SetRateLimit(20, 1)
+		start := time.Now()
+		_, err := projectService.BulkValidate(projectService.NewBulkOptions(configs[:3]).SetRateLimit(1000))
+		Expect(err).To(BeNil())
+		Expect(time.Since(start)).To(BeNumerically(">=", 100*time.Millisecond))
+		Expect(projectService.GetThrottleStats().Requests).To(Equal(int64(3)))
+	})
+	It(`Skips the configurations when the context is done`, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		result, err := projectService.BulkDeployWithContext(ctx, newOptions(configs))
+		Expect(err).ToNot(BeNil())
+		Expect(result.Stopped).To(BeFalse())
+		Expect(result.Succeeded()).To(Equal(0))
+		Expect(result.Items[4].Status).To(Equal(projectv1.BulkItemResult_Status_Skipped))
+		Expect(result.Items[4].Err.Error()).To(ContainSubstring("context canceled"))
+	})
+	It(`Invoke BulkValidate with error: Param validation error`, func() {
+		_, err := projectService.BulkValidate(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = projectService.BulkDeploy(projectService.NewBulkOptions(nil))
+		Expect(err).ToNot(BeNil())
+		_, err = projectService.BulkUndeploy(projectService.NewBulkOptions([]projectv1.ConfigRef{{ProjectID: projectID}}))
+		Expect(err).ToNot(BeNil())
+		_, err = projectService.BulkDeleteConfigs(new(projectv1.BulkOptions))
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Invoke NewBulkOptions successfully`, func() {
+		items := []projectv1.ConfigRef{{ProjectID: "project", ConfigID: "config"}}
+		options := projectService.NewBulkOptions(nil).
+			SetConfigs(items).
+			SetMaxParallel(8).
+			SetRateLimit(2.5).
+			SetMaxFailureRatio(0.1).
+			SetWait(true).
+			SetPollInterval(time.Second).
+			SetMaxPollInterval(time.Minute).
+			SetBackoffFactor(2).
+			SetItemTimeout(time.Hour).
+			SetHeaders(map[string]string{"foo": "bar"})
+		Expect(options.Configs).To(Equal(items))
+		Expect(options.MaxParallel).To(Equal(8))
+		Expect(options.RateLimit).To(Equal(2.5))
+		Expect(options.MaxFailureRatio).To(Equal(0.1))
+		Expect(options.Wait).To(BeTrue())
+		Expect(options.PollInterval).To(Equal(time.Second))
+		Expect(options.MaxPollInterval).To(Equal(time.Minute))
+		Expect(options.BackoffFactor).To(Equal(2.0))
+		Expect(options.ItemTimeout).To(Equal(time.Hour))
+		Expect(options.Headers).To(Equal(map[string]string{"foo": "bar"}))
+	})
+})
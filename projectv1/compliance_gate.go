@@ -0,0 +1,318 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/project-go-sdk/common"
+	"github.com/go-openapi/strfmt"
+)
+
+// CraSummary : The Code Risk Analyzer results of a scan, with the rule counts parsed into numbers.
+type CraSummary struct {
+	// The version of Code Risk Analyzer that ran the scan.
+	CraVersion string `json:"cra_version,omitempty"`
+
+	// The status of the scan.
+	Status string `json:"status,omitempty"`
+
+	// The number of rules that were applied in the scan.
+	Total int64 `json:"total"`
+
+	// The number of rules that passed.
+	Passed int64 `json:"passed"`
+
+	// The number of rules that failed.
+	Failed int64 `json:"failed"`
+
+	// The number of rules that were skipped.
+	Skipped int64 `json:"skipped"`
+
+	// The time of the scan.
+	Timestamp *strfmt.DateTime `json:"timestamp,omitempty"`
+}
+
+// String returns the counts of the summary, such as "passed 40 of 42 rules (0 failed, 2 skipped)".
+func (summary *CraSummary) String() string {
+	return fmt.Sprintf("passed %d of %d rules (%d failed, %d skipped)", summary.Passed, summary.Total, summary.Failed, summary.Skipped)
+}
+
+// ParseCraLogs : Parse the rule counts of Code Risk Analyzer logs into numbers
+// Counts that are not reported are 0, except for the total, which is the sum of the other counts. The result is nil if
+// the logs are nil.
+func ParseCraLogs(logs *ProjectConfigMetadataCodeRiskAnalyzerLogs) (result *CraSummary, err error) {
+	if logs == nil {
+		return
+	}
+	summary := &CraSummary{
+		CraVersion: core.StringNilMapper(logs.CraVersion),
+		Status:     core.StringNilMapper(logs.Status),
+		Timestamp:  logs.Timestamp,
+	}
+	totalReported := false
+	if logs.Summary != nil {
+		for _, count := range []struct {
+			name   string
+			value  *string
+			result *int64
+		}{
+			{"total", logs.Summary.Total, &summary.Total},
+			{"passed", logs.Summary.Passed, &summary.Passed},
+			{"failed", logs.Summary.Failed, &summary.Failed},
+			{"skipped", logs.Summary.Skipped, &summary.Skipped},
+		} {
+			value := strings.TrimSpace(core.StringNilMapper(count.value))
+			if value == "" {
+				continue
+			}
+			*count.result, err = strconv.ParseInt(value, 10, 64)
+			if err != nil || *count.result < 0 {
+				err = core.SDKErrorf(err, fmt.Sprintf("the %s count of the Code Risk Analyzer logs is not a count: '%s'", count.name, value),
+					"invalid-cra-logs", common.GetComponentInfo())
+				return
+			}
+			totalReported = totalReported || count.name == "total"
+		}
+	}
+	if !totalReported {
+		summary.Total = summary.Passed + summary.Failed + summary.Skipped
+	}
+	return summary, nil
+}
+
+// ComplianceGate : Thresholds for the Code Risk Analyzer results of a configuration, which must be met before it is
+// approved or deployed.
+type ComplianceGate struct {
+	// The maximum number of failed rules. Nil allows any number.
+	MaxFailed *int64 `json:"max_failed,omitempty"`
+
+	// The maximum number of skipped rules. Nil allows any number.
+	MaxSkipped *int64 `json:"max_skipped,omitempty"`
+
+	// Whether to block configurations that have no Code Risk Analyzer results.
+	RequireScan bool `json:"require_scan"`
+
+	// Whether to block configurations whose scan status is not passed.
+	RequirePassedStatus bool `json:"require_passed_status"`
+}
+
+// NewComplianceGate : Instantiate ComplianceGate
+// The gate blocks configurations that have failed rules or no Code Risk Analyzer results.
+func NewComplianceGate() *ComplianceGate {
+	return &ComplianceGate{
+		MaxFailed:   core.Int64Ptr(0),
+		RequireScan: true,
+	}
+}
+
+// ComplianceVerdict : The outcome of evaluating Code Risk Analyzer results against a compliance gate.
+type ComplianceVerdict struct {
+	// Whether the results meet the thresholds of the gate.
+	Allowed bool `json:"allowed"`
+
+	// The results that were evaluated. It is nil if there are none.
+	Summary *CraSummary `json:"summary,omitempty"`
+
+	// The reasons why the results do not meet the thresholds.
+	Reasons []string `json:"reasons,omitempty"`
+}
+
+// String returns the verdict as a sentence, such as "Blocked: 3 rules failed, more than the 0 that are allowed. Code
+// Risk Analyzer passed 39 of 42 rules (3 failed, 0 skipped)."
+func (verdict *ComplianceVerdict) String() string {
+	var builder strings.Builder
+	if verdict.Allowed {
+		builder.WriteString("Allowed.")
+	} else {
+		fmt.Fprintf(&builder, "Blocked: %s.", strings.Join(verdict.Reasons, "; "))
+	}
+	if verdict.Summary != nil {
+		fmt.Fprintf(&builder, " Code Risk Analyzer %s.", verdict.Summary.String())
+	}
+	return builder.String()
+}
+
+// Err returns a *ComplianceGateError if the verdict blocks the configuration, and nil if it allows it.
+func (verdict *ComplianceVerdict) Err() error {
+	if verdict.Allowed {
+		return nil
+	}
+	return &ComplianceGateError{Verdict: verdict}
+}
+
+// ComplianceGateError : The error returned when the Code Risk Analyzer results of a configuration do not meet the
+// thresholds of a compliance gate.
+type ComplianceGateError struct {
+	// The verdict that blocked the configuration.
+	Verdict *ComplianceVerdict
+}
+
+// Error returns the verdict.
+func (e *ComplianceGateError) Error() string {
+	return "compliance gate: " + e.Verdict.String()
+}
+
+// Evaluate : Evaluate Code Risk Analyzer logs against the gate
+// An error is returned if the counts of the logs are not numbers; a verdict that blocks the logs is not an error.
+func (gate *ComplianceGate) Evaluate(logs *ProjectConfigMetadataCodeRiskAnalyzerLogs) (*ComplianceVerdict, error) {
+	summary, err := ParseCraLogs(logs)
+	if err != nil {
+		return nil, err
+	}
+	verdict := &ComplianceVerdict{Summary: summary}
+	if summary == nil {
+		if gate.RequireScan {
+			verdict.Reasons = append(verdict.Reasons, "there are no Code Risk Analyzer results")
+		}
+	} else {
+		if gate.RequirePassedStatus && summary.Status != ProjectConfigMetadataCodeRiskAnalyzerLogs_Status_Passed {
+			verdict.Reasons = append(verdict.Reasons, fmt.Sprintf("the scan status is '%s', not '%s'", summary.Status,
+				ProjectConfigMetadataCodeRiskAnalyzerLogs_Status_Passed))
+		}
+		if gate.MaxFailed != nil && summary.Failed > *gate.MaxFailed {
+			verdict.Reasons = append(verdict.Reasons, fmt.Sprintf("%d rules failed, more than the %d that are allowed", summary.Failed, *gate.MaxFailed))
+		}
+		if gate.MaxSkipped != nil && summary.Skipped > *gate.MaxSkipped {
+			verdict.Reasons = append(verdict.Reasons, fmt.Sprintf("%d rules were skipped, more than the %d that are allowed", summary.Skipped, *gate.MaxSkipped))
+		}
+	}
+	verdict.Allowed = len(verdict.Reasons) == 0
+	return verdict, nil
+}
+
+// EvaluateConfig : Evaluate the Code Risk Analyzer logs of the last validation of a configuration against the gate
+func (gate *ComplianceGate) EvaluateConfig(config *ProjectConfig) (*ComplianceVerdict, error) {
+	var logs *ProjectConfigMetadataCodeRiskAnalyzerLogs
+	if config != nil && config.LastValidated != nil {
+		logs = config.LastValidated.CraLogs
+	}
+	return gate.Evaluate(logs)
+}
+
+// EvaluatePrevalidation : Evaluate the Code Risk Analyzer logs of a prevalidation against the gate
+func (gate *ComplianceGate) EvaluatePrevalidation(prevalidation *PrevalidateGetResponse) (*ComplianceVerdict, error) {
+	var logs *ProjectConfigMetadataCodeRiskAnalyzerLogs
+	if prevalidation != nil {
+		logs = prevalidation.CraLogs
+	}
+	return gate.Evaluate(logs)
+}
+
+// CheckComplianceGateOptions : The CheckComplianceGate options.
+type CheckComplianceGateOptions struct {
+	// The unique project ID.
+	ProjectID *string `json:"project_id" validate:"required,ne="`
+
+	// The unique configuration ID.
+	ID *string `json:"id" validate:"required,ne="`
+
+	// The thresholds to check. If it is not set, the gate of NewComplianceGate is checked.
+	Gate *ComplianceGate `json:"gate,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewCheckComplianceGateOptions : Instantiate CheckComplianceGateOptions
+func (*ProjectV1) NewCheckComplianceGateOptions(projectID string, id string) *CheckComplianceGateOptions {
+	return &CheckComplianceGateOptions{
+		ProjectID: core.StringPtr(projectID),
+		ID:        core.StringPtr(id),
+	}
+}
+
+// SetProjectID : Allow user to set ProjectID
+func (_options *CheckComplianceGateOptions) SetProjectID(projectID string) *CheckComplianceGateOptions {
+	_options.ProjectID = core.StringPtr(projectID)
+	return _options
+}
+
+// SetID : Allow user to set ID
+func (_options *CheckComplianceGateOptions) SetID(id string) *CheckComplianceGateOptions {
+	_options.ID = core.StringPtr(id)
+	return _options
+}
+
+// SetGate : Allow user to set Gate
+func (_options *CheckComplianceGateOptions) SetGate(gate *ComplianceGate) *CheckComplianceGateOptions {
+	_options.Gate = gate
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *CheckComplianceGateOptions) SetHeaders(param map[string]string) *CheckComplianceGateOptions {
+	options.Headers = param
+	return options
+}
+
+// CheckComplianceGate : Check the Code Risk Analyzer results of a configuration before it is approved or deployed
+// Get the configuration and evaluate the Code Risk Analyzer logs of its last validation against the gate. The verdict
+// is always returned; if it blocks the configuration, the error is a *ComplianceGateError, so a pipeline can stop
+// before calling Approve or DeployConfig.
+func (project *ProjectV1) CheckComplianceGate(checkComplianceGateOptions *CheckComplianceGateOptions) (result *ComplianceVerdict, err error) {
+	result, err = project.CheckComplianceGateWithContext(context.Background(), checkComplianceGateOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CheckComplianceGateWithContext is an alternate form of the CheckComplianceGate method which supports a Context parameter
+func (project *ProjectV1) CheckComplianceGateWithContext(ctx context.Context, checkComplianceGateOptions *CheckComplianceGateOptions) (result *ComplianceVerdict, err error) {
+	err = core.ValidateNotNil(checkComplianceGateOptions, "checkComplianceGateOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(checkComplianceGateOptions, "checkComplianceGateOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	config, _, err := project.GetConfigWithContext(ctx, &GetConfigOptions{
+		ProjectID: checkComplianceGateOptions.ProjectID,
+		ID:        checkComplianceGateOptions.ID,
+		Headers:   checkComplianceGateOptions.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-config-error")
+		return
+	}
+	return evaluateComplianceGate(checkComplianceGateOptions.Gate, config)
+}
+
+// evaluateComplianceGate evaluates the Code Risk Analyzer logs of a configuration against a gate, or against the gate
+// of NewComplianceGate if the gate is nil, and returns a *ComplianceGateError if the verdict blocks the configuration.
+func evaluateComplianceGate(gate *ComplianceGate, config *ProjectConfig) (verdict *ComplianceVerdict, err error) {
+	if gate == nil {
+		gate = NewComplianceGate()
+	}
+	verdict, err = gate.EvaluateConfig(config)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "invalid-cra-logs")
+		return
+	}
+	if gateErr := verdict.Err(); gateErr != nil {
+		err = core.SDKErrorf(gateErr, fmt.Sprintf("configuration '%s' does not meet the compliance gate: %s", configIDOf(config), verdict.String()),
+			"compliance-gate-blocked", common.GetComponentInfo())
+	}
+	return
+}
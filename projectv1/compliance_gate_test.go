@@ -0,0 +1,172 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1_test
+
+import (
+	"errors"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/project-go-sdk/projectv1"
+	"github.com/IBM/project-go-sdk/projectv1fake"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Compliance gate`, func() {
+	craLogs := func(status string, passed string, failed string, skipped string) *projectv1.ProjectConfigMetadataCodeRiskAnalyzerLogs {
+		return &projectv1.ProjectConfigMetadataCodeRiskAnalyzerLogs{
+			CraVersion: core.StringPtr("2.0.4"),
+			Status:     core.StringPtr(status),
+			Summary: &projectv1.CodeRiskAnalyzerLogsSummary{
+				Passed:  core.StringPtr(passed),
+				Failed:  core.StringPtr(failed),
+				Skipped: core.StringPtr(skipped),
+			},
+		}
+	}
+
+	It(`Parses the rule counts of Code Risk Analyzer logs`, func() {
+		summary, err := projectv1.ParseCraLogs(craLogs(projectv1.ProjectConfigMetadataCodeRiskAnalyzerLogs_Status_Passed, "40", "0", "2"))
+		Expect(err).To(BeNil())
+		Expect(summary.Total).To(Equal(int64(42)))
+		Expect(summary.Passed).To(Equal(int64(40)))
+		Expect(summary.Skipped).To(Equal(int64(2)))
+		Expect(summary.CraVersion).To(Equal("2.0.4"))
+		Expect(summary.String()).To(Equal("passed 40 of 42 rules (0 failed, 2 skipped)"))
+
+		logs := craLogs(projectv1.ProjectConfigMetadataCodeRiskAnalyzerLogs_Status_Passed, "40", "", "2")
+		logs.Summary.Total = core.StringPtr("45")
+		summary, err = projectv1.ParseCraLogs(logs)
+		Expect(err).To(BeNil())
+		Expect(summary.Total).To(Equal(int64(45)))
+		Expect(summary.Failed).To(Equal(int64(0)))
+
+		summary, err = projectv1.ParseCraLogs(nil)
+		Expect(err).To(BeNil())
+		Expect(summary).To(BeNil())
+
+		_, err = projectv1.ParseCraLogs(craLogs(projectv1.ProjectConfigMetadataCodeRiskAnalyzerLogs_Status_Failed, "40", "three", "0"))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(Equal("the failed count of the Code Risk Analyzer logs is not a count: 'three'"))
+		_, err = projectv1.ParseCraLogs(craLogs(projectv1.ProjectConfigMetadataCodeRiskAnalyzerLogs_Status_Failed, "40", "-1", "0"))
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Evaluates Code Risk Analyzer logs against thresholds`, func() {
+		gate := projectv1.NewComplianceGate()
+		verdict, err := gate.Evaluate(craLogs(projectv1.ProjectConfigMetadataCodeRiskAnalyzerLogs_Status_Passed, "40", "0", "2"))
+		Expect(err).To(BeNil())
+		Expect(verdict.Allowed).To(BeTrue())
+		Expect(verdict.Err()).To(BeNil())
+		Expect(verdict.String()).To(Equal("Allowed. Code Risk Analyzer passed 40 of 42 rules (0 failed, 2 skipped)."))
+
+		verdict, err = gate.Evaluate(craLogs(projectv1.ProjectConfigMetadataCodeRiskAnalyzerLogs_Status_Failed, "39", "3", "0"))
+		Expect(err).To(BeNil())
+		Expect(verdict.Allowed).To(BeFalse())
+		Expect(verdict.String()).To(Equal("Blocked: 3 rules failed, more than the 0 that are allowed. " +
+			"Code Risk Analyzer passed 39 of 42 rules (3 failed, 0 skipped)."))
+		var gateErr *projectv1.ComplianceGateError
+		Expect(errors.As(verdict.Err(), &gateErr)).To(BeTrue())
+		Expect(gateErr.Verdict).To(Equal(verdict))
+
+		verdict, err = gate.Evaluate(nil)
+		Expect(err).To(BeNil())
+		Expect(verdict.String()).To(Equal("Blocked: there are no Code Risk Analyzer results."))
+
+		gate = &projectv1.ComplianceGate{MaxFailed: core.Int64Ptr(5), MaxSkipped: core.Int64Ptr(1), RequirePassedStatus: true}
+		verdict, err = gate.Evaluate(craLogs(projectv1.ProjectConfigMetadataCodeRiskAnalyzerLogs_Status_Failed, "39", "3", "2"))
+		Expect(err).To(BeNil())
+		Expect(verdict.Reasons).To(Equal([]string{
+			"the scan status is 'failed', not 'passed'",
+			"2 rules were skipped, more than the 1 that are allowed",
+		}))
+		verdict, err = gate.Evaluate(nil)
+		Expect(err).To(BeNil())
+		Expect(verdict.Allowed).To(BeTrue())
+		Expect(verdict.String()).To(Equal("Allowed."))
+
+		verdict, err = gate.EvaluatePrevalidation(&projectv1.PrevalidateGetResponse{
+			CraLogs: craLogs(projectv1.ProjectConfigMetadataCodeRiskAnalyzerLogs_Status_Passed, "10", "0", "0"),
+		})
+		Expect(err).To(BeNil())
+		Expect(verdict.Allowed).To(BeTrue())
+	})
+
+	Describe(`CheckComplianceGate`, func() {
+		var server *projectv1fake.Server
+		var projectService *projectv1.ProjectV1
+		var projectID string
+		var configID string
+
+		BeforeEach(func() {
+			var err error
+			server = projectv1fake.NewServer()
+			projectService, err = server.NewProjectV1()
+			Expect(err).To(BeNil())
+
+			project, _, err := projectService.CreateProject(projectService.NewCreateProjectOptions(
+				&projectv1.ProjectPrototypeDefinition{Name: core.StringPtr("my-project")}, "us-south", "Default"))
+			Expect(err).To(BeNil())
+			projectID = *project.ID
+			config, _, err := projectService.CreateConfig(projectService.NewCreateConfigOptions(projectID,
+				&projectv1.ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype{Name: core.StringPtr("my-config")}))
+			Expect(err).To(BeNil())
+			configID = *config.ID
+		})
+		AfterEach(func() {
+			server.Close()
+		})
+
+		validate := func(logs *projectv1.ProjectConfigMetadataCodeRiskAnalyzerLogs) {
+			server.SetCraLogs(configID, logs)
+			_, _, err := projectService.ValidateConfig(projectService.NewValidateConfigOptions(projectID, configID))
+			Expect(err).To(BeNil())
+		}
+
+		It(`Allows a configuration that meets the gate`, func() {
+			validate(craLogs(projectv1.ProjectConfigMetadataCodeRiskAnalyzerLogs_Status_Passed, "40", "0", "2"))
+
+			verdict, err := projectService.CheckComplianceGate(projectService.NewCheckComplianceGateOptions(projectID, configID))
+			Expect(err).To(BeNil())
+			Expect(verdict.Allowed).To(BeTrue())
+			Expect(verdict.Summary.Passed).To(Equal(int64(40)))
+		})
+		It(`Blocks a configuration that does not meet the gate`, func() {
+			validate(craLogs(projectv1.ProjectConfigMetadataCodeRiskAnalyzerLogs_Status_Failed, "39", "3", "0"))
+
+			verdict, err := projectService.CheckComplianceGate(projectService.NewCheckComplianceGateOptions(projectID, configID))
+			Expect(verdict.Allowed).To(BeFalse())
+			var gateErr *projectv1.ComplianceGateError
+			Expect(errors.As(err, &gateErr)).To(BeTrue())
+			Expect(err.Error()).To(Equal("configuration '" + configID + "' does not meet the compliance gate: Blocked: 3 rules failed, " +
+				"more than the 0 that are allowed. Code Risk Analyzer passed 39 of 42 rules (3 failed, 0 skipped)."))
+
+			verdict, err = projectService.CheckComplianceGate(projectService.NewCheckComplianceGateOptions(projectID, configID).
+				SetGate(&projectv1.ComplianceGate{MaxFailed: core.Int64Ptr(3)}))
+			Expect(err).To(BeNil())
+			Expect(verdict.Allowed).To(BeTrue())
+		})
+		It(`Blocks a configuration that has not been scanned`, func() {
+			verdict, err := projectService.CheckComplianceGate(projectService.NewCheckComplianceGateOptions(projectID, configID))
+			Expect(err).ToNot(BeNil())
+			Expect(verdict.Summary).To(BeNil())
+			Expect(verdict.Reasons).To(Equal([]string{"there are no Code Risk Analyzer results"}))
+
+			_, err = projectService.CheckComplianceGate(nil)
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
@@ -0,0 +1,50 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1
+
+// definitionResponse returns the properties of a configuration definition as a ProjectConfigDefinitionResponse,
+// whichever concrete type holds them. It returns nil for a nil or unknown definition.
+func definitionResponse(definition ProjectConfigDefinitionResponseIntf) *ProjectConfigDefinitionResponse {
+	switch def := definition.(type) {
+	case *ProjectConfigDefinitionResponse:
+		return def
+	case *ProjectConfigDefinitionResponseDAConfigDefinitionPropertiesResponse:
+		return &ProjectConfigDefinitionResponse{
+			ComplianceProfile: def.ComplianceProfile,
+			LocatorID:         def.LocatorID,
+			Members:           def.Members,
+			Uses:              def.Uses,
+			Description:       def.Description,
+			Name:              def.Name,
+			Authorizations:    def.Authorizations,
+			Inputs:            def.Inputs,
+			Settings:          def.Settings,
+			EnvironmentID:     def.EnvironmentID,
+		}
+	case *ProjectConfigDefinitionResponseResourceConfigDefinitionPropertiesResponse:
+		return &ProjectConfigDefinitionResponse{
+			ResourceCrns:   def.ResourceCrns,
+			Description:    def.Description,
+			Name:           def.Name,
+			Authorizations: def.Authorizations,
+			Inputs:         def.Inputs,
+			Settings:       def.Settings,
+			EnvironmentID:  def.EnvironmentID,
+		}
+	}
+	return nil
+}
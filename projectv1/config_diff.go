@@ -0,0 +1,401 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/project-go-sdk/common"
+)
+
+// Constants associated with the ConfigVersionChange.Property property.
+// The definition property that the change is in.
+const (
+	ConfigVersionChange_Property_Authorizations    = "authorizations"
+	ConfigVersionChange_Property_ComplianceProfile = "compliance_profile"
+	ConfigVersionChange_Property_Inputs            = "inputs"
+	ConfigVersionChange_Property_LocatorID         = "locator_id"
+	ConfigVersionChange_Property_Members           = "members"
+	ConfigVersionChange_Property_Settings          = "settings"
+)
+
+// Constants associated with the ConfigVersionChange.Operation property.
+// The JSON patch operation of the change.
+const (
+	ConfigVersionChange_Operation_Add     = "add"
+	ConfigVersionChange_Operation_Remove  = "remove"
+	ConfigVersionChange_Operation_Replace = "replace"
+)
+
+// diffedProperties are the definition properties that DiffConfigVersions compares, in the order of the diff.
+var diffedProperties = []string{
+	ConfigVersionChange_Property_LocatorID,
+	ConfigVersionChange_Property_Inputs,
+	ConfigVersionChange_Property_Settings,
+	ConfigVersionChange_Property_Authorizations,
+	ConfigVersionChange_Property_ComplianceProfile,
+	ConfigVersionChange_Property_Members,
+}
+
+// DiffConfigVersionsOptions : The DiffConfigVersions options.
+type DiffConfigVersionsOptions struct {
+	// The unique project ID.
+	ProjectID *string `json:"project_id" validate:"required,ne="`
+
+	// The unique configuration ID.
+	ID *string `json:"id" validate:"required,ne="`
+
+	// The version of the configuration to compare from, such as the deployed version.
+	FromVersion *int64 `json:"from_version" validate:"required"`
+
+	// The version of the configuration to compare to, such as the draft version.
+	ToVersion *int64 `json:"to_version" validate:"required"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewDiffConfigVersionsOptions : Instantiate DiffConfigVersionsOptions
+func (*ProjectV1) NewDiffConfigVersionsOptions(projectID string, id string, fromVersion int64, toVersion int64) *DiffConfigVersionsOptions {
+	return &DiffConfigVersionsOptions{
+		ProjectID:   core.StringPtr(projectID),
+		ID:          core.StringPtr(id),
+		FromVersion: core.Int64Ptr(fromVersion),
+		ToVersion:   core.Int64Ptr(toVersion),
+	}
+}
+
+// SetProjectID : Allow user to set ProjectID
+func (_options *DiffConfigVersionsOptions) SetProjectID(projectID string) *DiffConfigVersionsOptions {
+	_options.ProjectID = core.StringPtr(projectID)
+	return _options
+}
+
+// SetID : Allow user to set ID
+func (_options *DiffConfigVersionsOptions) SetID(id string) *DiffConfigVersionsOptions {
+	_options.ID = core.StringPtr(id)
+	return _options
+}
+
+// SetFromVersion : Allow user to set FromVersion
+func (_options *DiffConfigVersionsOptions) SetFromVersion(fromVersion int64) *DiffConfigVersionsOptions {
+	_options.FromVersion = core.Int64Ptr(fromVersion)
+	return _options
+}
+
+// SetToVersion : Allow user to set ToVersion
+func (_options *DiffConfigVersionsOptions) SetToVersion(toVersion int64) *DiffConfigVersionsOptions {
+	_options.ToVersion = core.Int64Ptr(toVersion)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *DiffConfigVersionsOptions) SetHeaders(param map[string]string) *DiffConfigVersionsOptions {
+	options.Headers = param
+	return options
+}
+
+// ConfigVersionChange : A difference between the definitions of two configuration versions.
+type ConfigVersionChange struct {
+	// The definition property that the change is in.
+	Property string `json:"property"`
+
+	// The JSON pointer of the changed value in the definition, such as "/inputs/region".
+	Path string `json:"path"`
+
+	// The JSON patch operation of the change.
+	Operation string `json:"operation"`
+
+	// The value in the version that is compared from. It is nil for an addition.
+	From interface{} `json:"from,omitempty"`
+
+	// The value in the version that is compared to. It is nil for a removal.
+	To interface{} `json:"to,omitempty"`
+}
+
+// ConfigVersionDiff : The differences between the definitions of two configuration versions.
+// The inputs, settings, authorizations, locator ID, stack members and compliance profile of the definitions are
+// compared.
+type ConfigVersionDiff struct {
+	// The unique project ID.
+	ProjectID string `json:"project_id"`
+
+	// The unique configuration ID.
+	ConfigID string `json:"config_id"`
+
+	// The version that is compared from.
+	FromVersion int64 `json:"from_version"`
+
+	// The version that is compared to.
+	ToVersion int64 `json:"to_version"`
+
+	// The changes, in the order of the definition properties and then of their paths. The values are not redacted.
+	Changes []ConfigVersionChange `json:"changes"`
+
+	// The names of the inputs that the stack definition of the configuration marks as secure, with the password type.
+	SecureInputs []string `json:"secure_inputs,omitempty"`
+}
+
+// HasChanges returns true if the definitions of the versions differ.
+func (diff *ConfigVersionDiff) HasChanges() bool {
+	return len(diff.Changes) > 0
+}
+
+// PropertyChanges returns the changes in a definition property.
+func (diff *ConfigVersionDiff) PropertyChanges(property string) []ConfigVersionChange {
+	var changes []ConfigVersionChange
+	for _, change := range diff.Changes {
+		if change.Property == property {
+			changes = append(changes, change)
+		}
+	}
+	return changes
+}
+
+// String renders the diff as text, with a line per change prefixed with "+" for an addition, "-" for a removal and
+// "~" for a replacement. The values of API keys, of secure inputs and of the inputs that the service masked are
+// replaced with RedactedValue.
+func (diff *ConfigVersionDiff) String() string {
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "Configuration %s, version %d -> %d\n", diff.ConfigID, diff.FromVersion, diff.ToVersion)
+	if !diff.HasChanges() {
+		builder.WriteString("No changes.\n")
+		return builder.String()
+	}
+	for _, change := range diff.Changes {
+		switch change.Operation {
+		case ConfigVersionChange_Operation_Add:
+			fmt.Fprintf(&builder, "+ %s: %s\n", change.Path, diff.renderValue(change.Path, change.To))
+		case ConfigVersionChange_Operation_Remove:
+			fmt.Fprintf(&builder, "- %s: %s\n", change.Path, diff.renderValue(change.Path, change.From))
+		default:
+			fmt.Fprintf(&builder, "~ %s: %s -> %s\n", change.Path,
+				diff.renderValue(change.Path, change.From), diff.renderValue(change.Path, change.To))
+		}
+	}
+	return builder.String()
+}
+
+// JSONPatch renders the diff as a JSON patch (RFC 6902) that turns the definition of the version that is compared
+// from into the definition of the version that is compared to. Secrets are redacted as by String, so the patch sets
+// them to RedactedValue; use the Changes for their values.
+func (diff *ConfigVersionDiff) JSONPatch() ([]byte, error) {
+	type operation struct {
+		Op    string      `json:"op"`
+		Path  string      `json:"path"`
+		Value interface{} `json:"value,omitempty"`
+	}
+	operations := make([]operation, 0, len(diff.Changes))
+	for _, change := range diff.Changes {
+		operations = append(operations, operation{Op: change.Operation, Path: change.Path, Value: diff.redactValue(change.Path, change.To)})
+	}
+	data, err := json.Marshal(operations)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "json-patch-marshal-error", common.GetComponentInfo())
+	}
+	return data, nil
+}
+
+// DiffConfigVersions : Compare two versions of a configuration
+// Get the two versions of the configuration and return the differences of their definitions. Compare the deployed
+// version with the draft version to review the changes before they are approved.
+func (project *ProjectV1) DiffConfigVersions(diffConfigVersionsOptions *DiffConfigVersionsOptions) (result *ConfigVersionDiff, err error) {
+	result, err = project.DiffConfigVersionsWithContext(context.Background(), diffConfigVersionsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DiffConfigVersionsWithContext is an alternate form of the DiffConfigVersions method which supports a Context parameter
+func (project *ProjectV1) DiffConfigVersionsWithContext(ctx context.Context, diffConfigVersionsOptions *DiffConfigVersionsOptions) (result *ConfigVersionDiff, err error) {
+	err = core.ValidateNotNil(diffConfigVersionsOptions, "diffConfigVersionsOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(diffConfigVersionsOptions, "diffConfigVersionsOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	versions := make([]*ProjectConfigVersion, 2)
+	for i, version := range []*int64{diffConfigVersionsOptions.FromVersion, diffConfigVersionsOptions.ToVersion} {
+		versions[i], _, err = project.GetConfigVersionWithContext(ctx, &GetConfigVersionOptions{
+			ProjectID: diffConfigVersionsOptions.ProjectID,
+			ID:        diffConfigVersionsOptions.ID,
+			Version:   version,
+			Headers:   diffConfigVersionsOptions.Headers,
+		})
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "get-config-version-error")
+			return
+		}
+	}
+
+	result = &ConfigVersionDiff{
+		ProjectID:   *diffConfigVersionsOptions.ProjectID,
+		ConfigID:    *diffConfigVersionsOptions.ID,
+		FromVersion: *diffConfigVersionsOptions.FromVersion,
+		ToVersion:   *diffConfigVersionsOptions.ToVersion,
+		Changes:     diffDefinitions(versions[0].Definition, versions[1].Definition),
+	}
+
+	// The input variables of the stack definition of a stack configuration say which of its inputs are secure.
+	if core.StringNilMapper(versions[1].DeploymentModel) == ProjectConfig_DeploymentModel_Stack {
+		var stackDefinition *StackDefinition
+		stackDefinition, err = project.findStackDefinition(ctx, diffConfigVersionsOptions.ProjectID,
+			diffConfigVersionsOptions.ID, diffConfigVersionsOptions.Headers)
+		if err != nil {
+			result = nil
+			return
+		}
+		if stackDefinition != nil && stackDefinition.StackDefinition != nil {
+			for _, variable := range stackDefinition.StackDefinition.Inputs {
+				if core.StringNilMapper(variable.Type) == StackDefinitionInputVariable_Type_Password && variable.Name != nil {
+					result.SecureInputs = append(result.SecureInputs, *variable.Name)
+				}
+			}
+		}
+	}
+	return
+}
+
+// diffDefinitions returns the changes between two configuration definitions.
+func diffDefinitions(from ProjectConfigDefinitionResponseIntf, to ProjectConfigDefinitionResponseIntf) []ConfigVersionChange {
+	fromProperties := definitionProperties(definitionResponse(from))
+	toProperties := definitionProperties(definitionResponse(to))
+	changes := []ConfigVersionChange{}
+	for _, property := range diffedProperties {
+		diffValues(property, "/"+property, fromProperties[property], toProperties[property], &changes)
+	}
+	return changes
+}
+
+// diffValues appends the changes between two decoded JSON values. Objects are compared by property and arrays by
+// index, so that a change is reported at the deepest path where the values differ.
+func diffValues(property string, path string, from interface{}, to interface{}, changes *[]ConfigVersionChange) {
+	if reflect.DeepEqual(from, to) {
+		return
+	}
+	change := ConfigVersionChange{Property: property, Path: path, From: from, To: to}
+	switch {
+	case from == nil:
+		change.Operation = ConfigVersionChange_Operation_Add
+		*changes = append(*changes, change)
+		return
+	case to == nil:
+		change.Operation = ConfigVersionChange_Operation_Remove
+		*changes = append(*changes, change)
+		return
+	}
+
+	switch fromValue := from.(type) {
+	case map[string]interface{}:
+		if toValue, ok := to.(map[string]interface{}); ok {
+			names := make([]string, 0, len(fromValue)+len(toValue))
+			for name := range fromValue {
+				names = append(names, name)
+			}
+			for name := range toValue {
+				if _, found := fromValue[name]; !found {
+					names = append(names, name)
+				}
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				diffValues(property, path+"/"+escapeJSONPointer(name), fromValue[name], toValue[name], changes)
+			}
+			return
+		}
+	case []interface{}:
+		if toValue, ok := to.([]interface{}); ok {
+			shared := len(fromValue)
+			if len(toValue) < shared {
+				shared = len(toValue)
+			}
+			for i := 0; i < shared; i++ {
+				diffValues(property, path+"/"+strconv.Itoa(i), fromValue[i], toValue[i], changes)
+			}
+			for i := shared; i < len(toValue); i++ {
+				diffValues(property, path+"/"+strconv.Itoa(i), nil, toValue[i], changes)
+			}
+			// Items are removed from the end, so that the indexes of a JSON patch stay valid.
+			for i := len(fromValue) - 1; i >= shared; i-- {
+				diffValues(property, path+"/"+strconv.Itoa(i), fromValue[i], nil, changes)
+			}
+			return
+		}
+	}
+	change.Operation = ConfigVersionChange_Operation_Replace
+	*changes = append(*changes, change)
+}
+
+// escapeJSONPointer escapes a property name for a JSON pointer (RFC 6901).
+func escapeJSONPointer(name string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(name, "~", "~0"), "/", "~1")
+}
+
+// redactValue returns a value of the diff at a path with its secrets replaced with RedactedValue: the API key of the
+// authorizations, the secure inputs and the inputs that the service masked. References are not redacted, because
+// they do not hold the values that they refer to.
+func (diff *ConfigVersionDiff) redactValue(path string, value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~")
+	}
+	if len(segments) == 1 {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return value
+		}
+		redacted := make(map[string]interface{}, len(object))
+		for name, property := range object {
+			redacted[name] = diff.redactValue(path+"/"+escapeJSONPointer(name), property)
+		}
+		return redacted
+	}
+	parent, name := segments[0], segments[1]
+	switch {
+	case isSecretProperty(parent, name):
+		return RedactedValue
+	case parent == ConfigVersionChange_Property_Inputs && len(segments) == 2 && isMaskedValue(value):
+		return RedactedValue
+	case parent == ConfigVersionChange_Property_Inputs && containsString(diff.SecureInputs, name) && !IsInputReference(value):
+		return RedactedValue
+	}
+	return value
+}
+
+// renderValue returns the JSON encoding of a value of the diff at a path, with its secrets redacted.
+func (diff *ConfigVersionDiff) renderValue(path string, value interface{}) string {
+	value = diff.redactValue(path, value)
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
@@ -0,0 +1,175 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1_test
+
+import (
+	"encoding/json"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/project-go-sdk/projectv1"
+	"github.com/IBM/project-go-sdk/projectv1fake"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`DiffConfigVersions`, func() {
+	var server *projectv1fake.Server
+	var projectService *projectv1.ProjectV1
+	var projectID string
+	var configID string
+
+	BeforeEach(func() {
+		var err error
+		server = projectv1fake.NewServer()
+		projectService, err = server.NewProjectV1()
+		Expect(err).To(BeNil())
+
+		project, _, err := projectService.CreateProject(projectService.NewCreateProjectOptions(
+			&projectv1.ProjectPrototypeDefinition{Name: core.StringPtr("my-project")}, "us-south", "Default"))
+		Expect(err).To(BeNil())
+		projectID = *project.ID
+		config, _, err := projectService.CreateConfig(projectService.NewCreateConfigOptions(projectID,
+			&projectv1.ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype{
+				Name:      core.StringPtr("network"),
+				LocatorID: core.StringPtr("catalog.version-1"),
+				Inputs:    map[string]interface{}{"region": "us-south", "zones": []interface{}{"1", "2", "3"}, "old": true},
+				Settings:  map[string]interface{}{"TF_LOG": "debug"},
+				Authorizations: &projectv1.ProjectConfigAuth{
+					Method: core.StringPtr("api_key"),
+					ApiKey: core.StringPtr("secret-api-key"),
+				},
+			}))
+		Expect(err).To(BeNil())
+		configID = *config.ID
+
+		// Approve the first version, so that the update creates a second version.
+		_, _, err = projectService.ValidateConfig(projectService.NewValidateConfigOptions(projectID, configID))
+		Expect(err).To(BeNil())
+		_, _, err = projectService.GetConfig(projectService.NewGetConfigOptions(projectID, configID))
+		Expect(err).To(BeNil())
+		_, _, err = projectService.Approve(projectService.NewApproveOptions(projectID, configID))
+		Expect(err).To(BeNil())
+		_, _, err = projectService.UpdateConfig(projectService.NewUpdateConfigOptions(projectID, configID,
+			&projectv1.ProjectConfigDefinitionPatch{
+				LocatorID: core.StringPtr("catalog.version-2"),
+				Inputs:    map[string]interface{}{"region": "eu-de", "zones": []interface{}{"1"}, "new/name": 1},
+				Authorizations: &projectv1.ProjectConfigAuth{
+					Method: core.StringPtr("api_key"),
+					ApiKey: core.StringPtr("other-api-key"),
+				},
+			}))
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Compares the definitions of two versions`, func() {
+		diff, err := projectService.DiffConfigVersions(projectService.NewDiffConfigVersionsOptions(projectID, configID, 1, 2))
+		Expect(err).To(BeNil())
+		Expect(diff.FromVersion).To(Equal(int64(1)))
+		Expect(diff.ToVersion).To(Equal(int64(2)))
+		Expect(diff.PropertyChanges(projectv1.ConfigVersionChange_Property_Settings)).To(BeEmpty())
+		Expect(diff.PropertyChanges(projectv1.ConfigVersionChange_Property_LocatorID)).To(Equal([]projectv1.ConfigVersionChange{{
+			Property:  projectv1.ConfigVersionChange_Property_LocatorID,
+			Path:      "/locator_id",
+			Operation: projectv1.ConfigVersionChange_Operation_Replace,
+			From:      "catalog.version-1",
+			To:        "catalog.version-2",
+		}}))
+
+		Expect(diff.String()).To(Equal("Configuration " + configID + ", version 1 -> 2\n" +
+			`~ /locator_id: "catalog.version-1" -> "catalog.version-2"` + "\n" +
+			`+ /inputs/new~1name: 1` + "\n" +
+			`- /inputs/old: true` + "\n" +
+			`~ /inputs/region: "us-south" -> "eu-de"` + "\n" +
+			`- /inputs/zones/2: "3"` + "\n" +
+			`- /inputs/zones/1: "2"` + "\n" +
+			`~ /authorizations/api_key: "[redacted]" -> "[redacted]"` + "\n"))
+
+		patch, err := diff.JSONPatch()
+		Expect(err).To(BeNil())
+		var operations []map[string]interface{}
+		Expect(json.Unmarshal(patch, &operations)).To(Succeed())
+		Expect(operations).To(HaveLen(7))
+		Expect(operations[1]).To(Equal(map[string]interface{}{"op": "add", "path": "/inputs/new~1name", "value": float64(1)}))
+		Expect(operations[2]).To(Equal(map[string]interface{}{"op": "remove", "path": "/inputs/old"}))
+		Expect(operations[6]).To(Equal(map[string]interface{}{"op": "replace", "path": "/authorizations/api_key", "value": projectv1.RedactedValue}))
+		Expect(string(patch)).ToNot(ContainSubstring("other-api-key"))
+		Expect(diff.Changes[6].To).To(Equal("other-api-key"))
+	})
+	It(`Redacts the secure inputs of a stack configuration`, func() {
+		stack, _, err := projectService.CreateConfig(projectService.NewCreateConfigOptions(projectID,
+			&projectv1.ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype{
+				Name:    core.StringPtr("stack"),
+				Inputs:  map[string]interface{}{"admin_password": "first-password", "region": "us-south"},
+				Members: []projectv1.StackMember{{Name: core.StringPtr("network"), ConfigID: core.StringPtr(configID)}},
+			}))
+		Expect(err).To(BeNil())
+		_, _, err = projectService.CreateStackDefinition(projectService.NewCreateStackDefinitionOptions(projectID, *stack.ID,
+			&projectv1.StackDefinitionBlockPrototype{Inputs: []projectv1.StackDefinitionInputVariable{
+				{Name: core.StringPtr("region"), Type: core.StringPtr("string")},
+				{Name: core.StringPtr("admin_password"), Type: core.StringPtr(projectv1.StackDefinitionInputVariable_Type_Password)},
+			}}))
+		Expect(err).To(BeNil())
+		_, _, err = projectService.ValidateConfig(projectService.NewValidateConfigOptions(projectID, *stack.ID))
+		Expect(err).To(BeNil())
+		_, _, err = projectService.GetConfig(projectService.NewGetConfigOptions(projectID, *stack.ID))
+		Expect(err).To(BeNil())
+		_, _, err = projectService.Approve(projectService.NewApproveOptions(projectID, *stack.ID))
+		Expect(err).To(BeNil())
+		_, _, err = projectService.UpdateConfig(projectService.NewUpdateConfigOptions(projectID, *stack.ID,
+			&projectv1.ProjectConfigDefinitionPatch{
+				Inputs: map[string]interface{}{"admin_password": "second-password", "region": "eu-de"},
+			}))
+		Expect(err).To(BeNil())
+
+		diff, err := projectService.DiffConfigVersions(projectService.NewDiffConfigVersionsOptions(projectID, *stack.ID, 1, 2))
+		Expect(err).To(BeNil())
+		Expect(diff.SecureInputs).To(Equal([]string{"admin_password"}))
+		Expect(diff.String()).To(HaveSuffix(
+			`~ /inputs/admin_password: "[redacted]" -> "[redacted]"` + "\n" +
+				`~ /inputs/region: "us-south" -> "eu-de"` + "\n"))
+
+		patch, err := diff.JSONPatch()
+		Expect(err).To(BeNil())
+		Expect(string(patch)).ToNot(ContainSubstring("second-password"))
+		var operations []map[string]interface{}
+		Expect(json.Unmarshal(patch, &operations)).To(Succeed())
+		Expect(operations).To(ContainElement(map[string]interface{}{"op": "replace", "path": "/inputs/admin_password", "value": projectv1.RedactedValue}))
+		Expect(operations).To(ContainElement(map[string]interface{}{"op": "replace", "path": "/inputs/region", "value": "eu-de"}))
+	})
+	It(`Reports no changes for the same version`, func() {
+		diff, err := projectService.DiffConfigVersions(projectService.NewDiffConfigVersionsOptions(projectID, configID, 2, 2))
+		Expect(err).To(BeNil())
+		Expect(diff.HasChanges()).To(BeFalse())
+		Expect(diff.String()).To(HaveSuffix("No changes.\n"))
+		patch, err := diff.JSONPatch()
+		Expect(err).To(BeNil())
+		Expect(string(patch)).To(Equal("[]"))
+	})
+	It(`Returns an error for a missing version`, func() {
+		_, err := projectService.DiffConfigVersions(projectService.NewDiffConfigVersionsOptions(projectID, configID, 1, 3))
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Returns an error for missing options`, func() {
+		_, err := projectService.DiffConfigVersions(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = projectService.DiffConfigVersions(&projectv1.DiffConfigVersionsOptions{})
+		Expect(err).ToNot(BeNil())
+	})
+})
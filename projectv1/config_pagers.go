@@ -0,0 +1,484 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/project-go-sdk/common"
+)
+
+// DefaultStackMembersPageSize is the number of member configurations in each page of a StackMembersPager when
+// ListStackMembersOptions.Limit is not set.
+const DefaultStackMembersPageSize = 10
+
+// pagerItems returns a function that calls yield with the items of the pages that getNext returns while hasNext is
+// true. A page is only requested once the items of the previous page have been consumed. If a page cannot be
+// retrieved, the error is passed to yield with the zero value of T and the iteration ends. It is the iterator of the All
+// methods of the pagers, and the loop of the GetAll methods of the pagers of this file.
+func pagerItems[T any](hasNext func() bool, getNext func() ([]T, error)) func(yield func(T, error) bool) {
+	return func(yield func(T, error) bool) {
+		for hasNext() {
+			page, err := getNext()
+			if err != nil {
+				var zero T
+				yield(zero, core.RepurposeSDKProblem(err, "error-getting-next-page"))
+				return
+			}
+			for _, item := range page {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// listPageWithContext sends a GET request for a page of a collection whose operation does not accept a page token.
+// The token, if set, is sent as the "token" query parameter. It returns the raw response and the token of the next
+// page, which is nil unless the response has a "next" link.
+func (project *ProjectV1) listPageWithContext(ctx context.Context, path string, pathParamsMap map[string]string, operationID string, operationName string, headers map[string]string, token *string) (rawResponse map[string]json.RawMessage, next *string, err error) {
+	builder := core.NewRequestBuilder(core.GET)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = project.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(project.Service.Options.URL, path, pathParamsMap)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "url-resolve-error", common.GetComponentInfo())
+		return
+	}
+
+	sdkHeaders := common.GetSdkHeaders("project", "V1", operationID)
+	for headerName, headerValue := range sdkHeaders {
+		builder.AddHeader(headerName, headerValue)
+	}
+
+	for headerName, headerValue := range headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+	builder.AddHeader("Accept", "application/json")
+
+	if token != nil {
+		builder.AddQuery("token", fmt.Sprint(*token))
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "build-error", common.GetComponentInfo())
+		return
+	}
+
+	_, err = project.Service.Request(request, &rawResponse)
+	if err != nil {
+		core.EnrichHTTPProblem(err, operationName, getServiceComponentInfo())
+		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		return
+	}
+
+	var nextLink *PaginationLink
+	err = core.UnmarshalModel(rawResponse, "next", &nextLink, UnmarshalPaginationLink)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "next-error", common.GetComponentInfo())
+		return
+	}
+	if nextLink != nil && nextLink.Href != nil {
+		next, err = core.GetQueryParam(nextLink.Href, "token")
+		if err != nil {
+			errMsg := fmt.Sprintf("error retrieving 'token' query parameter from URL '%s': %s", *nextLink.Href, err.Error())
+			err = core.SDKErrorf(err, errMsg, "get-query-error", common.GetComponentInfo())
+			return
+		}
+	}
+	return
+}
+
+// ConfigVersionsPager can be used to simplify the use of the "ListConfigVersions" method.
+// The service currently returns all versions in a single page; the pager follows the "next" link of the
+// response if one is returned.
+type ConfigVersionsPager struct {
+	hasNext     bool
+	options     *ListConfigVersionsOptions
+	client      *ProjectV1
+	pageContext struct {
+		next *string
+	}
+}
+
+// NewConfigVersionsPager returns a new ConfigVersionsPager instance.
+func (project *ProjectV1) NewConfigVersionsPager(options *ListConfigVersionsOptions) (pager *ConfigVersionsPager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+
+	var optionsCopy ListConfigVersionsOptions = *options
+	pager = &ConfigVersionsPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  project,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *ConfigVersionsPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *ConfigVersionsPager) GetNextWithContext(ctx context.Context) (page []ProjectConfigVersionSummary, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	err = core.ValidateStruct(pager.options, "listConfigVersionsOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	pathParamsMap := map[string]string{
+		"project_id": *pager.options.ProjectID,
+		"id":         *pager.options.ID,
+	}
+	rawResponse, next, err := pager.client.listPageWithContext(ctx, `/v1/projects/{project_id}/configs/{id}/versions`, pathParamsMap,
+		"ListConfigVersions", "list_config_versions", pager.options.Headers, pager.pageContext.next)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+		return
+	}
+
+	var result *ProjectConfigVersionCollection
+	if rawResponse != nil {
+		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalProjectConfigVersionCollection)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			return
+		}
+		page = result.Versions
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *ConfigVersionsPager) GetAllWithContext(ctx context.Context) (allItems []ProjectConfigVersionSummary, err error) {
+	pagerItems(pager.HasNext, func() ([]ProjectConfigVersionSummary, error) {
+		return pager.GetNextWithContext(ctx)
+	})(func(item ProjectConfigVersionSummary, itemErr error) bool {
+		if itemErr != nil {
+			err = itemErr
+			return false
+		}
+		allItems = append(allItems, item)
+		return true
+	})
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *ConfigVersionsPager) GetNext() (page []ProjectConfigVersionSummary, err error) {
+	page, err = pager.GetNextWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *ConfigVersionsPager) GetAll() (allItems []ProjectConfigVersionSummary, err error) {
+	allItems, err = pager.GetAllWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ConfigResourcesPager can be used to simplify the use of the "ListConfigResources" method.
+// The service currently returns all resources in a single page; the pager follows the "next" link of the
+// response if one is returned.
+type ConfigResourcesPager struct {
+	hasNext     bool
+	options     *ListConfigResourcesOptions
+	client      *ProjectV1
+	pageContext struct {
+		next *string
+	}
+}
+
+// NewConfigResourcesPager returns a new ConfigResourcesPager instance.
+func (project *ProjectV1) NewConfigResourcesPager(options *ListConfigResourcesOptions) (pager *ConfigResourcesPager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+
+	var optionsCopy ListConfigResourcesOptions = *options
+	pager = &ConfigResourcesPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  project,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *ConfigResourcesPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *ConfigResourcesPager) GetNextWithContext(ctx context.Context) (page []ProjectConfigResource, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	err = core.ValidateStruct(pager.options, "listConfigResourcesOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	pathParamsMap := map[string]string{
+		"project_id": *pager.options.ProjectID,
+		"id":         *pager.options.ID,
+	}
+	rawResponse, next, err := pager.client.listPageWithContext(ctx, `/v1/projects/{project_id}/configs/{id}/resources`, pathParamsMap,
+		"ListConfigResources", "list_config_resources", pager.options.Headers, pager.pageContext.next)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+		return
+	}
+
+	var result *ProjectConfigResourceCollection
+	if rawResponse != nil {
+		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalProjectConfigResourceCollection)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+			return
+		}
+		page = result.Resources
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *ConfigResourcesPager) GetAllWithContext(ctx context.Context) (allItems []ProjectConfigResource, err error) {
+	pagerItems(pager.HasNext, func() ([]ProjectConfigResource, error) {
+		return pager.GetNextWithContext(ctx)
+	})(func(item ProjectConfigResource, itemErr error) bool {
+		if itemErr != nil {
+			err = itemErr
+			return false
+		}
+		allItems = append(allItems, item)
+		return true
+	})
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *ConfigResourcesPager) GetNext() (page []ProjectConfigResource, err error) {
+	page, err = pager.GetNextWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *ConfigResourcesPager) GetAll() (allItems []ProjectConfigResource, err error) {
+	allItems, err = pager.GetAllWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ListStackMembersOptions : The options of a StackMembersPager.
+type ListStackMembersOptions struct {
+	// The unique project ID.
+	ProjectID *string `json:"project_id" validate:"required,ne="`
+
+	// The unique ID of the stack configuration.
+	ID *string `json:"id" validate:"required,ne="`
+
+	// The number of member configurations to return in each page. Defaults to DefaultStackMembersPageSize.
+	Limit *int64 `json:"limit,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewListStackMembersOptions : Instantiate ListStackMembersOptions
+func (*ProjectV1) NewListStackMembersOptions(projectID string, id string) *ListStackMembersOptions {
+	return &ListStackMembersOptions{
+		ProjectID: core.StringPtr(projectID),
+		ID:        core.StringPtr(id),
+	}
+}
+
+// SetProjectID : Allow user to set ProjectID
+func (_options *ListStackMembersOptions) SetProjectID(projectID string) *ListStackMembersOptions {
+	_options.ProjectID = core.StringPtr(projectID)
+	return _options
+}
+
+// SetID : Allow user to set ID
+func (_options *ListStackMembersOptions) SetID(id string) *ListStackMembersOptions {
+	_options.ID = core.StringPtr(id)
+	return _options
+}
+
+// SetLimit : Allow user to set Limit
+func (_options *ListStackMembersOptions) SetLimit(limit int64) *ListStackMembersOptions {
+	_options.Limit = core.Int64Ptr(limit)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *ListStackMembersOptions) SetHeaders(param map[string]string) *ListStackMembersOptions {
+	options.Headers = param
+	return options
+}
+
+// StackMembersPager can be used to retrieve the member configurations of a stack configuration. The first page
+// retrieves the stack configuration with "GetConfig"; each page then retrieves up to Limit member configurations.
+type StackMembersPager struct {
+	hasNext     bool
+	options     *ListStackMembersOptions
+	client      *ProjectV1
+	pageContext struct {
+		members []StackMember
+		loaded  bool
+	}
+}
+
+// NewStackMembersPager returns a new StackMembersPager instance.
+func (project *ProjectV1) NewStackMembersPager(options *ListStackMembersOptions) (pager *StackMembersPager, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if options.Limit != nil && *options.Limit <= 0 {
+		err = core.SDKErrorf(nil, "the 'options.Limit' field must be greater than 0", "invalid-limit", common.GetComponentInfo())
+		return
+	}
+
+	var optionsCopy ListStackMembersOptions = *options
+	pager = &StackMembersPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  project,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *StackMembersPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *StackMembersPager) GetNextWithContext(ctx context.Context) (page []ProjectConfig, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	err = core.ValidateStruct(pager.options, "listStackMembersOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	if !pager.pageContext.loaded {
+		var stack *ProjectConfig
+		stack, _, err = pager.client.GetConfigWithContext(ctx, &GetConfigOptions{
+			ProjectID: pager.options.ProjectID,
+			ID:        pager.options.ID,
+			Headers:   pager.options.Headers,
+		})
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+			return
+		}
+		if definition := definitionResponse(stack.Definition); definition != nil {
+			pager.pageContext.members = definition.Members
+		}
+		pager.pageContext.loaded = true
+	}
+
+	limit := DefaultStackMembersPageSize
+	if pager.options.Limit != nil {
+		limit = int(*pager.options.Limit)
+	}
+	members := pager.pageContext.members
+	if len(members) > limit {
+		members = members[:limit]
+	}
+	for _, member := range members {
+		var config *ProjectConfig
+		config, _, err = pager.client.GetConfigWithContext(ctx, &GetConfigOptions{
+			ProjectID: pager.options.ProjectID,
+			ID:        member.ConfigID,
+			Headers:   pager.options.Headers,
+		})
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+			return nil, err
+		}
+		page = append(page, *config)
+	}
+	pager.pageContext.members = pager.pageContext.members[len(members):]
+	pager.hasNext = (len(pager.pageContext.members) > 0)
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *StackMembersPager) GetAllWithContext(ctx context.Context) (allItems []ProjectConfig, err error) {
+	pagerItems(pager.HasNext, func() ([]ProjectConfig, error) {
+		return pager.GetNextWithContext(ctx)
+	})(func(item ProjectConfig, itemErr error) bool {
+		if itemErr != nil {
+			err = itemErr
+			return false
+		}
+		allItems = append(allItems, item)
+		return true
+	})
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *StackMembersPager) GetNext() (page []ProjectConfig, err error) {
+	page, err = pager.GetNextWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *StackMembersPager) GetAll() (allItems []ProjectConfig, err error) {
+	allItems, err = pager.GetAllWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
@@ -0,0 +1,231 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/project-go-sdk/projectv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Config pagers`, func() {
+	var testServer *httptest.Server
+	var projectService *projectv1.ProjectV1
+	var requests []string
+
+	startServer := func(handler func(res http.ResponseWriter, req *http.Request)) {
+		requests = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.Method).To(Equal("GET"))
+			requests = append(requests, req.URL.RequestURI())
+			res.Header().Set("Content-type", "application/json")
+			handler(res, req)
+		}))
+		var serviceErr error
+		projectService, serviceErr = projectv1.NewProjectV1(&projectv1.ProjectV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	}
+
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	Describe(`ConfigVersionsPager`, func() {
+		listConfigVersionsPath := "/v1/projects/testString/configs/testString/versions"
+
+		It(`Use ConfigVersionsPager.GetNext with a single page`, func() {
+			startServer(func(res http.ResponseWriter, req *http.Request) {
+				Expect(req.URL.EscapedPath()).To(Equal(listConfigVersionsPath))
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{"versions": [{"state": "approved", "version": 1}, {"state": "draft", "version": 2}]}`)
+			})
+
+			pager, err := projectService.NewConfigVersionsPager(projectService.NewListConfigVersionsOptions("testString", "testString"))
+			Expect(err).To(BeNil())
+			Expect(pager.HasNext()).To(BeTrue())
+
+			page, err := pager.GetNext()
+			Expect(err).To(BeNil())
+			Expect(page).To(HaveLen(2))
+			Expect(*page[1].Version).To(Equal(int64(2)))
+			Expect(pager.HasNext()).To(BeFalse())
+
+			_, err = pager.GetNext()
+			Expect(err).ToNot(BeNil())
+		})
+		It(`Use ConfigVersionsPager.GetAll with a paginated response`, func() {
+			startServer(func(res http.ResponseWriter, req *http.Request) {
+				Expect(req.URL.EscapedPath()).To(Equal(listConfigVersionsPath))
+				res.WriteHeader(200)
+				if req.URL.Query().Get("token") == "" {
+					fmt.Fprint(res, `{"next": {"href": "https://myhost.com/somePath?token=abc"}, "versions": [{"version": 1}]}`)
+				} else {
+					fmt.Fprint(res, `{"versions": [{"version": 2}]}`)
+				}
+			})
+
+			pager, err := projectService.NewConfigVersionsPager(projectService.NewListConfigVersionsOptions("testString", "testString"))
+			Expect(err).To(BeNil())
+
+			allResults, err := pager.GetAll()
+			Expect(err).To(BeNil())
+			Expect(allResults).To(HaveLen(2))
+			Expect(requests).To(Equal([]string{listConfigVersionsPath, listConfigVersionsPath + "?token=abc"}))
+		})
+		It(`Invoke ConfigVersionsPager with error: Param validation error`, func() {
+			startServer(func(res http.ResponseWriter, req *http.Request) {})
+
+			_, err := projectService.NewConfigVersionsPager(nil)
+			Expect(err).ToNot(BeNil())
+
+			pager, err := projectService.NewConfigVersionsPager(new(projectv1.ListConfigVersionsOptions))
+			Expect(err).To(BeNil())
+			_, err = pager.GetAll()
+			Expect(err).ToNot(BeNil())
+			Expect(requests).To(BeEmpty())
+		})
+		It(`Invoke ConfigVersionsPager with error: Operation request error`, func() {
+			startServer(func(res http.ResponseWriter, req *http.Request) {
+				res.WriteHeader(404)
+			})
+
+			pager, err := projectService.NewConfigVersionsPager(projectService.NewListConfigVersionsOptions("testString", "testString"))
+			Expect(err).To(BeNil())
+			_, err = pager.GetNext()
+			Expect(err).ToNot(BeNil())
+		})
+	})
+
+	Describe(`ConfigResourcesPager`, func() {
+		listConfigResourcesPath := "/v1/projects/testString/configs/testString/resources"
+
+		It(`Use ConfigResourcesPager.GetAll successfully`, func() {
+			startServer(func(res http.ResponseWriter, req *http.Request) {
+				Expect(req.URL.EscapedPath()).To(Equal(listConfigResourcesPath))
+				res.WriteHeader(200)
+				switch req.URL.Query().Get("token") {
+				case "":
+					fmt.Fprint(res, `{"next": {"href": "https://myhost.com/somePath?token=2"}, "resources_count": 3, "resources": [{"resource_crn": "crn1"}, {"resource_crn": "crn2"}]}`)
+				default:
+					fmt.Fprint(res, `{"resources_count": 3, "resources": [{"resource_crn": "crn3"}]}`)
+				}
+			})
+
+			pager, err := projectService.NewConfigResourcesPager(projectService.NewListConfigResourcesOptions("testString", "testString"))
+			Expect(err).To(BeNil())
+
+			var crns []string
+			for pager.HasNext() {
+				page, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				for _, resource := range page {
+					crns = append(crns, *resource.ResourceCrn)
+				}
+			}
+			Expect(crns).To(Equal([]string{"crn1", "crn2", "crn3"}))
+			Expect(requests).To(HaveLen(2))
+		})
+		It(`Invoke ConfigResourcesPager with error: Param validation error`, func() {
+			startServer(func(res http.ResponseWriter, req *http.Request) {})
+
+			_, err := projectService.NewConfigResourcesPager(nil)
+			Expect(err).ToNot(BeNil())
+
+			pager, err := projectService.NewConfigResourcesPager(new(projectv1.ListConfigResourcesOptions))
+			Expect(err).To(BeNil())
+			_, err = pager.GetNext()
+			Expect(err).ToNot(BeNil())
+		})
+	})
+
+	Describe(`StackMembersPager`, func() {
+		serveStack := func(res http.ResponseWriter, req *http.Request) {
+			configID := strings.TrimPrefix(req.URL.EscapedPath(), "/v1/projects/testString/configs/")
+			res.WriteHeader(200)
+			if configID == "stack" {
+				fmt.Fprint(res, `{"id": "stack", "definition": {"members": [{"name": "a", "config_id": "c-a"}, {"name": "b", "config_id": "c-b"}, {"name": "c", "config_id": "c-c"}]}}`)
+				return
+			}
+			fmt.Fprintf(res, `{"id": "%s", "state": "deployed"}`, configID)
+		}
+
+		It(`Use StackMembersPager.GetNext successfully`, func() {
+			startServer(serveStack)
+
+			pager, err := projectService.NewStackMembersPager(projectService.NewListStackMembersOptions("testString", "stack").SetLimit(2))
+			Expect(err).To(BeNil())
+
+			page, err := pager.GetNext()
+			Expect(err).To(BeNil())
+			Expect(page).To(HaveLen(2))
+			Expect(*page[0].ID).To(Equal("c-a"))
+			Expect(pager.HasNext()).To(BeTrue())
+			Expect(requests).To(HaveLen(3))
+
+			page, err = pager.GetNext()
+			Expect(err).To(BeNil())
+			Expect(page).To(HaveLen(1))
+			Expect(*page[0].ID).To(Equal("c-c"))
+			Expect(pager.HasNext()).To(BeFalse())
+			Expect(requests).To(HaveLen(4))
+		})
+		It(`Use StackMembersPager.GetAll successfully`, func() {
+			startServer(serveStack)
+
+			pager, err := projectService.NewStackMembersPager(projectService.NewListStackMembersOptions("testString", "stack"))
+			Expect(err).To(BeNil())
+
+			allResults, err := pager.GetAll()
+			Expect(err).To(BeNil())
+			Expect(allResults).To(HaveLen(3))
+		})
+		It(`Invoke StackMembersPager with error: Param validation error`, func() {
+			startServer(serveStack)
+
+			_, err := projectService.NewStackMembersPager(nil)
+			Expect(err).ToNot(BeNil())
+			_, err = projectService.NewStackMembersPager(projectService.NewListStackMembersOptions("testString", "stack").SetLimit(0))
+			Expect(err).ToNot(BeNil())
+
+			pager, err := projectService.NewStackMembersPager(new(projectv1.ListStackMembersOptions))
+			Expect(err).To(BeNil())
+			_, err = pager.GetNext()
+			Expect(err).ToNot(BeNil())
+		})
+		It(`Invoke NewListStackMembersOptions successfully`, func() {
+			startServer(serveStack)
+
+			options := projectService.NewListStackMembersOptions("testString", "testString")
+			options.SetProjectID("projectID").SetID("stackID").SetLimit(5).SetHeaders(map[string]string{"foo": "bar"})
+			Expect(options.ProjectID).To(Equal(core.StringPtr("projectID")))
+			Expect(options.ID).To(Equal(core.StringPtr("stackID")))
+			Expect(options.Limit).To(Equal(core.Int64Ptr(5)))
+			Expect(options.Headers).To(Equal(map[string]string{"foo": "bar"}))
+		})
+	})
+})
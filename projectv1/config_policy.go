@@ -0,0 +1,326 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/project-go-sdk/common"
+)
+
+// Constants associated with the actions that a Policy evaluates.
+const (
+	PolicyAction_Approve      = "approve"
+	PolicyAction_Delete       = "delete"
+	PolicyAction_Deploy       = "deploy"
+	PolicyAction_ForceApprove = "force_approve"
+	PolicyAction_Undeploy     = "undeploy"
+)
+
+// Policy : A rule that a ProjectV1 client consults before it approves, force approves, deploys, undeploys or deletes a
+// configuration.
+type Policy interface {
+	// Evaluate decides whether an action is allowed on a configuration. The action is one of the PolicyAction_*
+	// values, and the configuration is its current state in the service.
+	Evaluate(ctx context.Context, config *ProjectConfig, action string) PolicyDecision
+}
+
+// PolicyDecision : The outcome of evaluating a policy.
+type PolicyDecision struct {
+	// Whether the action is allowed.
+	Allowed bool `json:"allowed"`
+
+	// Why the action is denied.
+	Reason string `json:"reason,omitempty"`
+}
+
+// AllowAction : Return a decision that allows an action.
+func AllowAction() PolicyDecision {
+	return PolicyDecision{Allowed: true}
+}
+
+// DenyAction : Return a decision that denies an action for a reason.
+func DenyAction(reason string) PolicyDecision {
+	return PolicyDecision{Reason: reason}
+}
+
+// PolicyFunc : An adapter to use a function as a Policy.
+type PolicyFunc func(ctx context.Context, config *ProjectConfig, action string) PolicyDecision
+
+// Evaluate calls the function.
+func (f PolicyFunc) Evaluate(ctx context.Context, config *ProjectConfig, action string) PolicyDecision {
+	return f(ctx, config, action)
+}
+
+// Policies : Combine policies into a policy that allows an action only if all of them allow it
+// The policies are evaluated in order, and the decision of the first one that denies the action is returned.
+func Policies(policies ...Policy) Policy {
+	return PolicyFunc(func(ctx context.Context, config *ProjectConfig, action string) PolicyDecision {
+		for _, policy := range policies {
+			if decision := policy.Evaluate(ctx, config, action); !decision.Allowed {
+				return decision
+			}
+		}
+		return AllowAction()
+	})
+}
+
+// PolicyDeniedError : The error returned when a policy denies an action. The request of the action is not sent.
+type PolicyDeniedError struct {
+	// The action that was denied. One of the PolicyAction_* values.
+	Action string
+
+	// The unique project ID.
+	ProjectID string
+
+	// The unique configuration ID.
+	ConfigID string
+
+	// Why the action was denied.
+	Reason string
+}
+
+// Error implements the error interface.
+func (e *PolicyDeniedError) Error() string {
+	return fmt.Sprintf("policy denied %s of configuration '%s': %s", e.Action, e.ConfigID, e.Reason)
+}
+
+// configActions are the methods of the actions on configurations that a policy is consulted for. The helpers that
+// approve, deploy, undeploy or delete configurations call them through this interface, so that a PolicyProjectV1
+// consults its policy for the actions of its helpers too.
+type configActions interface {
+	ApproveWithContext(ctx context.Context, approveOptions *ApproveOptions) (*ProjectConfigVersion, *core.DetailedResponse, error)
+	ForceApproveWithContext(ctx context.Context, forceApproveOptions *ForceApproveOptions) (*ProjectConfigVersion, *core.DetailedResponse, error)
+	DeployConfigWithContext(ctx context.Context, deployConfigOptions *DeployConfigOptions) (*ProjectConfigVersion, *core.DetailedResponse, error)
+	UndeployConfigWithContext(ctx context.Context, undeployConfigOptions *UndeployConfigOptions) (*ProjectConfigVersion, *core.DetailedResponse, error)
+	DeleteConfigWithContext(ctx context.Context, deleteConfigOptions *DeleteConfigOptions) (*ProjectConfigDelete, *core.DetailedResponse, error)
+}
+
+// PolicyProjectV1 : A ProjectV1 client that consults a policy before it approves, force approves, deploys, undeploys or
+// deletes a configuration
+// The policy is consulted by Approve, ForceApprove, DeployConfig, UndeployConfig and DeleteConfig, by their
+// *WithContext forms, and by the helpers that call them: RolloutConfig, DeployStack, ApplyManifest, BulkDeploy,
+// BulkUndeploy and BulkDeleteConfigs. The client gets the configuration before each of these requests and evaluates
+// the policy with the context of the call. When the policy denies the action, the request is not sent and the call
+// returns an error that wraps a *PolicyDeniedError, which errors.As finds. The configuration must be readable for the
+// action to be allowed.
+//
+// The other methods are those of the embedded ProjectV1, and its requests are sent without consulting the policy.
+type PolicyProjectV1 struct {
+	*ProjectV1
+
+	policy Policy
+}
+
+// NewPolicyProjectV1 : Wrap a ProjectV1 client in a client that consults a policy before actions on configurations
+// The wrapped client shares the service of the client, so its authenticator, retries and rate limit apply. A nil
+// policy allows every action.
+func NewPolicyProjectV1(project *ProjectV1, policy Policy) *PolicyProjectV1 {
+	return &PolicyProjectV1{ProjectV1: project, policy: policy}
+}
+
+// Clone makes a copy of "project" suitable for processing requests, with the same policy.
+func (project *PolicyProjectV1) Clone() *PolicyProjectV1 {
+	if core.IsNil(project) {
+		return nil
+	}
+	return &PolicyProjectV1{ProjectV1: project.ProjectV1.Clone(), policy: project.policy}
+}
+
+// Approve : Approve and merge a configuration draft, if the policy allows it
+func (project *PolicyProjectV1) Approve(approveOptions *ApproveOptions) (result *ProjectConfigVersion, response *core.DetailedResponse, err error) {
+	result, response, err = project.ApproveWithContext(context.Background(), approveOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ApproveWithContext is an alternate form of the Approve method which supports a Context parameter
+func (project *PolicyProjectV1) ApproveWithContext(ctx context.Context, approveOptions *ApproveOptions) (result *ProjectConfigVersion, response *core.DetailedResponse, err error) {
+	if approveOptions != nil {
+		err = project.checkPolicy(ctx, PolicyAction_Approve, approveOptions.ProjectID, approveOptions.ID, approveOptions.Headers)
+		if err != nil {
+			return
+		}
+	}
+	return project.ProjectV1.ApproveWithContext(ctx, approveOptions)
+}
+
+// ForceApprove : Force approve a project configuration, if the policy allows it
+func (project *PolicyProjectV1) ForceApprove(forceApproveOptions *ForceApproveOptions) (result *ProjectConfigVersion, response *core.DetailedResponse, err error) {
+	result, response, err = project.ForceApproveWithContext(context.Background(), forceApproveOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ForceApproveWithContext is an alternate form of the ForceApprove method which supports a Context parameter
+func (project *PolicyProjectV1) ForceApproveWithContext(ctx context.Context, forceApproveOptions *ForceApproveOptions) (result *ProjectConfigVersion, response *core.DetailedResponse, err error) {
+	if forceApproveOptions != nil {
+		err = project.checkPolicy(ctx, PolicyAction_ForceApprove, forceApproveOptions.ProjectID, forceApproveOptions.ID, forceApproveOptions.Headers)
+		if err != nil {
+			return
+		}
+	}
+	return project.ProjectV1.ForceApproveWithContext(ctx, forceApproveOptions)
+}
+
+// DeployConfig : Deploy a configuration, if the policy allows it
+func (project *PolicyProjectV1) DeployConfig(deployConfigOptions *DeployConfigOptions) (result *ProjectConfigVersion, response *core.DetailedResponse, err error) {
+	result, response, err = project.DeployConfigWithContext(context.Background(), deployConfigOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeployConfigWithContext is an alternate form of the DeployConfig method which supports a Context parameter
+func (project *PolicyProjectV1) DeployConfigWithContext(ctx context.Context, deployConfigOptions *DeployConfigOptions) (result *ProjectConfigVersion, response *core.DetailedResponse, err error) {
+	if deployConfigOptions != nil {
+		err = project.checkPolicy(ctx, PolicyAction_Deploy, deployConfigOptions.ProjectID, deployConfigOptions.ID, deployConfigOptions.Headers)
+		if err != nil {
+			return
+		}
+	}
+	return project.ProjectV1.DeployConfigWithContext(ctx, deployConfigOptions)
+}
+
+// UndeployConfig : Undeploy configuration resources, if the policy allows it
+func (project *PolicyProjectV1) UndeployConfig(undeployConfigOptions *UndeployConfigOptions) (result *ProjectConfigVersion, response *core.DetailedResponse, err error) {
+	result, response, err = project.UndeployConfigWithContext(context.Background(), undeployConfigOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// UndeployConfigWithContext is an alternate form of the UndeployConfig method which supports a Context parameter
+func (project *PolicyProjectV1) UndeployConfigWithContext(ctx context.Context, undeployConfigOptions *UndeployConfigOptions) (result *ProjectConfigVersion, response *core.DetailedResponse, err error) {
+	if undeployConfigOptions != nil {
+		err = project.checkPolicy(ctx, PolicyAction_Undeploy, undeployConfigOptions.ProjectID, undeployConfigOptions.ID, undeployConfigOptions.Headers)
+		if err != nil {
+			return
+		}
+	}
+	return project.ProjectV1.UndeployConfigWithContext(ctx, undeployConfigOptions)
+}
+
+// DeleteConfig : Delete a configuration, if the policy allows it
+func (project *PolicyProjectV1) DeleteConfig(deleteConfigOptions *DeleteConfigOptions) (result *ProjectConfigDelete, response *core.DetailedResponse, err error) {
+	result, response, err = project.DeleteConfigWithContext(context.Background(), deleteConfigOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteConfigWithContext is an alternate form of the DeleteConfig method which supports a Context parameter
+func (project *PolicyProjectV1) DeleteConfigWithContext(ctx context.Context, deleteConfigOptions *DeleteConfigOptions) (result *ProjectConfigDelete, response *core.DetailedResponse, err error) {
+	if deleteConfigOptions != nil {
+		err = project.checkPolicy(ctx, PolicyAction_Delete, deleteConfigOptions.ProjectID, deleteConfigOptions.ID, deleteConfigOptions.Headers)
+		if err != nil {
+			return
+		}
+	}
+	return project.ProjectV1.DeleteConfigWithContext(ctx, deleteConfigOptions)
+}
+
+// RolloutConfig : Validate, approve and deploy a configuration, with the approval and the deployment allowed by the
+// policy
+func (project *PolicyProjectV1) RolloutConfig(rolloutConfigOptions *RolloutConfigOptions) (result *RolloutReport, err error) {
+	result, err = project.RolloutConfigWithContext(context.Background(), rolloutConfigOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// RolloutConfigWithContext is an alternate form of the RolloutConfig method which supports a Context parameter
+func (project *PolicyProjectV1) RolloutConfigWithContext(ctx context.Context, rolloutConfigOptions *RolloutConfigOptions) (result *RolloutReport, err error) {
+	return project.rolloutConfig(ctx, project, rolloutConfigOptions)
+}
+
+// DeployStack : Deploy the members of a stack configuration, with each deployment allowed by the policy
+func (project *PolicyProjectV1) DeployStack(deployStackOptions *DeployStackOptions) (result *StackDeployment, err error) {
+	result, err = project.DeployStackWithContext(context.Background(), deployStackOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeployStackWithContext is an alternate form of the DeployStack method which supports a Context parameter
+func (project *PolicyProjectV1) DeployStackWithContext(ctx context.Context, deployStackOptions *DeployStackOptions) (result *StackDeployment, err error) {
+	return project.deployStack(ctx, project, deployStackOptions)
+}
+
+// ApplyManifest : Apply a manifest to the live state, with each deletion of a configuration allowed by the policy
+func (project *PolicyProjectV1) ApplyManifest(applyManifestOptions *ApplyManifestOptions) (result *ManifestPlan, err error) {
+	result, err = project.ApplyManifestWithContext(context.Background(), applyManifestOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ApplyManifestWithContext is an alternate form of the ApplyManifest method which supports a Context parameter
+func (project *PolicyProjectV1) ApplyManifestWithContext(ctx context.Context, applyManifestOptions *ApplyManifestOptions) (result *ManifestPlan, err error) {
+	return project.applyManifest(ctx, project, applyManifestOptions)
+}
+
+// BulkDeploy : Deploy configurations, with each deployment allowed by the policy
+func (project *PolicyProjectV1) BulkDeploy(bulkOptions *BulkOptions) (result *BulkResult, err error) {
+	result, err = project.BulkDeployWithContext(context.Background(), bulkOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// BulkDeployWithContext is an alternate form of the BulkDeploy method which supports a Context parameter
+func (project *PolicyProjectV1) BulkDeployWithContext(ctx context.Context, bulkOptions *BulkOptions) (result *BulkResult, err error) {
+	return project.bulkDeploy(ctx, project, bulkOptions)
+}
+
+// BulkUndeploy : Undeploy configurations, with each undeployment allowed by the policy
+func (project *PolicyProjectV1) BulkUndeploy(bulkOptions *BulkOptions) (result *BulkResult, err error) {
+	result, err = project.BulkUndeployWithContext(context.Background(), bulkOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// BulkUndeployWithContext is an alternate form of the BulkUndeploy method which supports a Context parameter
+func (project *PolicyProjectV1) BulkUndeployWithContext(ctx context.Context, bulkOptions *BulkOptions) (result *BulkResult, err error) {
+	return project.bulkUndeploy(ctx, project, bulkOptions)
+}
+
+// BulkDeleteConfigs : Delete configurations, with each deletion allowed by the policy
+func (project *PolicyProjectV1) BulkDeleteConfigs(bulkOptions *BulkOptions) (result *BulkResult, err error) {
+	result, err = project.BulkDeleteConfigsWithContext(context.Background(), bulkOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// BulkDeleteConfigsWithContext is an alternate form of the BulkDeleteConfigs method which supports a Context parameter
+func (project *PolicyProjectV1) BulkDeleteConfigsWithContext(ctx context.Context, bulkOptions *BulkOptions) (result *BulkResult, err error) {
+	return project.bulkDeleteConfigs(ctx, project, bulkOptions)
+}
+
+// checkPolicy gets a configuration and evaluates the policy for an action on it. It returns nil if there is no policy,
+// or if the IDs are missing, which the ProjectV1 method of the action then reports.
+func (project *PolicyProjectV1) checkPolicy(ctx context.Context, action string, projectID *string, configID *string, headers map[string]string) error {
+	if project.policy == nil || projectID == nil || configID == nil {
+		return nil
+	}
+	config, _, err := project.GetConfigWithContext(ctx, &GetConfigOptions{ProjectID: projectID, ID: configID, Headers: headers})
+	if err != nil {
+		return core.SDKErrorf(err, fmt.Sprintf("the policy for %s of configuration '%s' could not be evaluated", action, *configID),
+			"policy-config-err", common.GetComponentInfo())
+	}
+	decision := project.policy.Evaluate(ctx, config, action)
+	if !decision.Allowed {
+		denied := &PolicyDeniedError{Action: action, ProjectID: *projectID, ConfigID: *configID, Reason: decision.Reason}
+		return core.SDKErrorf(denied, "", "policy-denied", common.GetComponentInfo())
+	}
+	return nil
+}
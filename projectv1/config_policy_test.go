@@ -0,0 +1,260 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/project-go-sdk/projectv1"
+	"github.com/IBM/project-go-sdk/projectv1fake"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Policy`, func() {
+	var server *projectv1fake.Server
+	var projectService *projectv1.ProjectV1
+	var policyService *projectv1.PolicyProjectV1
+	var projectID string
+	var evaluations []string
+
+	definitionOf := func(config *projectv1.ProjectConfig) *projectv1.ProjectConfigDefinitionResponse {
+		definition, _ := config.Definition.(*projectv1.ProjectConfigDefinitionResponse)
+		return definition
+	}
+	noForceApproveInProd := projectv1.PolicyFunc(func(ctx context.Context, config *projectv1.ProjectConfig, action string) projectv1.PolicyDecision {
+		evaluations = append(evaluations, action)
+		if action == projectv1.PolicyAction_ForceApprove && core.StringNilMapper(definitionOf(config).EnvironmentID) == "prod" {
+			return projectv1.DenyAction("configurations in prod cannot be force approved")
+		}
+		return projectv1.AllowAction()
+	})
+	noUndeployCritical := projectv1.PolicyFunc(func(ctx context.Context, config *projectv1.ProjectConfig, action string) projectv1.PolicyDecision {
+		if action != projectv1.PolicyAction_Undeploy && action != projectv1.PolicyAction_Delete {
+			return projectv1.AllowAction()
+		}
+		tags, _ := definitionOf(config).Inputs["tags"].([]interface{})
+		for _, tag := range tags {
+			if tag == "critical" {
+				return projectv1.DenyAction("critical configurations cannot be undeployed")
+			}
+		}
+		return projectv1.AllowAction()
+	})
+
+	createConfig := func(environmentID string, tags ...interface{}) string {
+		config, _, err := projectService.CreateConfig(projectService.NewCreateConfigOptions(projectID,
+			&projectv1.ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype{
+				Name:          core.StringPtr("my-config"),
+				EnvironmentID: core.StringPtr(environmentID),
+				Inputs:        map[string]interface{}{"tags": tags},
+			}))
+		Expect(err).To(BeNil())
+		return *config.ID
+	}
+	stateOf := func(configID string) string {
+		config, _, err := projectService.GetConfig(projectService.NewGetConfigOptions(projectID, configID))
+		Expect(err).To(BeNil())
+		return *config.State
+	}
+
+	BeforeEach(func() {
+		var err error
+		server = projectv1fake.NewServer()
+		projectService, err = server.NewProjectV1()
+		Expect(err).To(BeNil())
+		project, _, err := projectService.CreateProject(projectService.NewCreateProjectOptions(
+			&projectv1.ProjectPrototypeDefinition{Name: core.StringPtr("my-project")}, "us-south", "Default"))
+		Expect(err).To(BeNil())
+		projectID = *project.ID
+		evaluations = nil
+		policyService = projectv1.NewPolicyProjectV1(projectService, projectv1.Policies(noForceApproveInProd, noUndeployCritical))
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Denies an action without sending its request`, func() {
+		configID := createConfig("prod")
+		server.FailAction(configID, "validate")
+		_, _, err := projectService.ValidateConfig(projectService.NewValidateConfigOptions(projectID, configID))
+		Expect(err).To(BeNil())
+		Expect(stateOf(configID)).To(Equal(projectv1.ProjectConfig_State_ValidatingFailed))
+
+		_, _, err = policyService.ForceApprove(projectService.NewForceApproveOptions(projectID, configID, "known issue"))
+		Expect(err).ToNot(BeNil())
+		var denied *projectv1.PolicyDeniedError
+		Expect(errors.As(err, &denied)).To(BeTrue())
+		Expect(*denied).To(Equal(projectv1.PolicyDeniedError{
+			Action:    projectv1.PolicyAction_ForceApprove,
+			ProjectID: projectID,
+			ConfigID:  configID,
+			Reason:    "configurations in prod cannot be force approved",
+		}))
+		Expect(err.Error()).To(ContainSubstring("policy denied force_approve of configuration '" + configID +
+			"': configurations in prod cannot be force approved"))
+		Expect(stateOf(configID)).To(Equal(projectv1.ProjectConfig_State_ValidatingFailed))
+		Expect(evaluations).To(Equal([]string{projectv1.PolicyAction_ForceApprove}))
+	})
+	It(`Allows the actions that the policy allows`, func() {
+		configID := createConfig("dev")
+		server.FailAction(configID, "validate")
+		_, _, err := projectService.ValidateConfig(projectService.NewValidateConfigOptions(projectID, configID))
+		Expect(err).To(BeNil())
+		Expect(stateOf(configID)).To(Equal(projectv1.ProjectConfig_State_ValidatingFailed))
+
+		_, _, err = policyService.ForceApprove(projectService.NewForceApproveOptions(projectID, configID, "known issue"))
+		Expect(err).To(BeNil())
+		_, _, err = policyService.DeployConfig(projectService.NewDeployConfigOptions(projectID, configID))
+		Expect(err).To(BeNil())
+		Expect(stateOf(configID)).To(Equal(projectv1.ProjectConfig_State_Deployed))
+		_, _, err = policyService.UndeployConfig(projectService.NewUndeployConfigOptions(projectID, configID))
+		Expect(err).To(BeNil())
+		Expect(stateOf(configID)).To(Equal(projectv1.ProjectConfig_State_Approved))
+		_, _, err = policyService.DeleteConfig(projectService.NewDeleteConfigOptions(projectID, configID))
+		Expect(err).To(BeNil())
+		Expect(evaluations).To(Equal([]string{
+			projectv1.PolicyAction_ForceApprove,
+			projectv1.PolicyAction_Deploy,
+			projectv1.PolicyAction_Undeploy,
+			projectv1.PolicyAction_Delete,
+		}))
+	})
+	It(`Is consulted by the helpers that act on configurations`, func() {
+		configID := createConfig("prod", "critical")
+		report, err := policyService.RolloutConfig(projectService.NewRolloutConfigOptions(projectID, configID).
+			SetPollInterval(time.Millisecond))
+		Expect(err).To(BeNil())
+		Expect(report.Config.State).To(Equal(core.StringPtr(projectv1.ProjectConfig_State_Deployed)))
+		Expect(evaluations).To(Equal([]string{projectv1.PolicyAction_Approve, projectv1.PolicyAction_Deploy}))
+
+		_, _, err = policyService.UndeployConfig(projectService.NewUndeployConfigOptions(projectID, configID))
+		var denied *projectv1.PolicyDeniedError
+		Expect(errors.As(err, &denied)).To(BeTrue())
+		Expect(denied.Reason).To(Equal("critical configurations cannot be undeployed"))
+		Expect(stateOf(configID)).To(Equal(projectv1.ProjectConfig_State_Deployed))
+	})
+	It(`Does not retry denied actions`, func() {
+		projectService.Service.EnableRetries(3, time.Millisecond)
+		policyService = projectv1.NewPolicyProjectV1(projectService, noForceApproveInProd)
+
+		configID := createConfig("prod")
+		_, _, err := policyService.ForceApprove(projectService.NewForceApproveOptions(projectID, configID, "known issue"))
+		var problem *core.SDKProblem
+		Expect(errors.As(err, &problem)).To(BeTrue())
+		var denied *projectv1.PolicyDeniedError
+		Expect(errors.As(err, &denied)).To(BeTrue())
+		Expect(evaluations).To(Equal([]string{projectv1.PolicyAction_ForceApprove}))
+	})
+	It(`Is kept by a clone of the client`, func() {
+		configID := createConfig("dev", "critical")
+		_, _, err := policyService.Clone().DeleteConfig(projectService.NewDeleteConfigOptions(projectID, configID))
+		var denied *projectv1.PolicyDeniedError
+		Expect(errors.As(err, &denied)).To(BeTrue())
+		Expect(denied.Action).To(Equal(projectv1.PolicyAction_Delete))
+	})
+	It(`Is not consulted by the wrapped client or without a policy`, func() {
+		configID := createConfig("prod")
+		_, _, err := projectService.ValidateConfig(projectService.NewValidateConfigOptions(projectID, configID))
+		Expect(err).To(BeNil())
+		Expect(stateOf(configID)).To(Equal(projectv1.ProjectConfig_State_Validated))
+		_, _, err = projectService.ForceApprove(projectService.NewForceApproveOptions(projectID, configID, "known issue"))
+		Expect(err).To(BeNil())
+		_, _, err = projectv1.NewPolicyProjectV1(projectService, nil).DeployConfig(projectService.NewDeployConfigOptions(projectID, configID))
+		Expect(err).To(BeNil())
+		Expect(evaluations).To(BeEmpty())
+	})
+	It(`Is consulted by every action and by every helper that acts on configurations`, func() {
+		configID := createConfig("dev")
+		stack, _, err := projectService.CreateConfig(projectService.NewCreateConfigOptions(projectID,
+			&projectv1.ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype{
+				Name:    core.StringPtr("stack"),
+				Members: []projectv1.StackMember{{Name: core.StringPtr("member"), ConfigID: core.StringPtr(configID)}},
+			}))
+		Expect(err).To(BeNil())
+		var denied []string
+		policyService = projectv1.NewPolicyProjectV1(projectService,
+			projectv1.PolicyFunc(func(ctx context.Context, config *projectv1.ProjectConfig, action string) projectv1.PolicyDecision {
+				denied = append(denied, action)
+				return projectv1.DenyAction("frozen")
+			}))
+		expectDenied := func(err error) {
+			var deniedErr *projectv1.PolicyDeniedError
+			ExpectWithOffset(1, errors.As(err, &deniedErr)).To(BeTrue())
+		}
+
+		_, _, err = policyService.Approve(projectService.NewApproveOptions(projectID, configID))
+		expectDenied(err)
+		_, _, err = policyService.ForceApprove(projectService.NewForceApproveOptions(projectID, configID, "known issue"))
+		expectDenied(err)
+		_, _, err = policyService.DeployConfig(projectService.NewDeployConfigOptions(projectID, configID))
+		expectDenied(err)
+		_, _, err = policyService.UndeployConfig(projectService.NewUndeployConfigOptions(projectID, configID))
+		expectDenied(err)
+		_, _, err = policyService.DeleteConfig(projectService.NewDeleteConfigOptions(projectID, configID))
+		expectDenied(err)
+
+		_, err = policyService.RolloutConfig(projectService.NewRolloutConfigOptions(projectID, configID).
+			SetPollInterval(time.Millisecond))
+		expectDenied(err)
+		deployment, err := policyService.DeployStack(projectService.NewDeployStackOptions(projectID, *stack.ID).
+			SetPollInterval(time.Millisecond))
+		Expect(err).ToNot(BeNil())
+		expectDenied(deployment.Members[0].Err)
+		_, err = policyService.ApplyManifest(projectService.NewApplyManifestOptions(&projectv1.Manifest{
+			Project: &projectv1.ManifestProject{
+				ID:         core.StringPtr(projectID),
+				Definition: &projectv1.ProjectPrototypeDefinition{Name: core.StringPtr("my-project")},
+			},
+		}).SetPrune(true))
+		expectDenied(err)
+		bulkOptions := projectService.NewBulkOptions([]projectv1.ConfigRef{{ProjectID: projectID, ConfigID: configID}})
+		result, err := policyService.BulkDeploy(bulkOptions)
+		Expect(err).ToNot(BeNil())
+		expectDenied(result.Items[0].Err)
+		result, err = policyService.BulkUndeploy(bulkOptions)
+		Expect(err).ToNot(BeNil())
+		expectDenied(result.Items[0].Err)
+		result, err = policyService.BulkDeleteConfigs(bulkOptions)
+		Expect(err).ToNot(BeNil())
+		expectDenied(result.Items[0].Err)
+
+		Expect(denied).To(Equal([]string{
+			projectv1.PolicyAction_Approve,
+			projectv1.PolicyAction_ForceApprove,
+			projectv1.PolicyAction_Deploy,
+			projectv1.PolicyAction_Undeploy,
+			projectv1.PolicyAction_Delete,
+			projectv1.PolicyAction_Approve,
+			projectv1.PolicyAction_Deploy,
+			projectv1.PolicyAction_Delete,
+			projectv1.PolicyAction_Deploy,
+			projectv1.PolicyAction_Undeploy,
+			projectv1.PolicyAction_Delete,
+		}))
+		Expect(stateOf(configID)).To(Equal(projectv1.ProjectConfig_State_Validated))
+	})
+	It(`Denies an action when the configuration cannot be read`, func() {
+		_, _, err := policyService.DeleteConfig(projectService.NewDeleteConfigOptions(projectID, "unknown"))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("the policy for delete of configuration 'unknown' could not be evaluated"))
+		Expect(evaluations).To(BeEmpty())
+	})
+})
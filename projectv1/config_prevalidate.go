@@ -0,0 +1,253 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/project-go-sdk/common"
+)
+
+// PrevalidateOptions : The Prevalidate options.
+type PrevalidateOptions struct {
+	// The unique project ID.
+	ProjectID *string `json:"project_id" validate:"required,ne="`
+
+	// The unique configuration ID.
+	ID *string `json:"id" validate:"required,ne="`
+
+	// The definition to prevalidate.
+	Definition ProjectConfigDefinitionPrototypeIntf `json:"definition" validate:"required"`
+
+	// A Schematics workspace to use for deploying this deployable architecture. See
+	// CreatePrevalidateOptions.Schematics.
+	Schematics *SchematicsWorkspace `json:"schematics,omitempty"`
+
+	// The delay before the first poll of the result and between the first two polls. Defaults to
+	// DefaultWaitPollInterval.
+	PollInterval time.Duration `json:"poll_interval,omitempty"`
+
+	// The upper bound for the delay between polls. See WaitForConfigStateOptions.MaxPollInterval.
+	MaxPollInterval time.Duration `json:"max_poll_interval,omitempty"`
+
+	// The factor by which the delay between polls grows. See WaitForConfigStateOptions.BackoffFactor.
+	BackoffFactor float64 `json:"backoff_factor,omitempty"`
+
+	// The maximum time to wait for the result. A zero value waits until the context is done.
+	Timeout time.Duration `json:"timeout,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewPrevalidateOptions : Instantiate PrevalidateOptions
+func (*ProjectV1) NewPrevalidateOptions(projectID string, id string, definition ProjectConfigDefinitionPrototypeIntf) *PrevalidateOptions {
+	return &PrevalidateOptions{
+		ProjectID:  core.StringPtr(projectID),
+		ID:         core.StringPtr(id),
+		Definition: definition,
+	}
+}
+
+// SetProjectID : Allow user to set ProjectID
+func (_options *PrevalidateOptions) SetProjectID(projectID string) *PrevalidateOptions {
+	_options.ProjectID = core.StringPtr(projectID)
+	return _options
+}
+
+// SetID : Allow user to set ID
+func (_options *PrevalidateOptions) SetID(id string) *PrevalidateOptions {
+	_options.ID = core.StringPtr(id)
+	return _options
+}
+
+// SetDefinition : Allow user to set Definition
+func (_options *PrevalidateOptions) SetDefinition(definition ProjectConfigDefinitionPrototypeIntf) *PrevalidateOptions {
+	_options.Definition = definition
+	return _options
+}
+
+// SetSchematics : Allow user to set Schematics
+func (_options *PrevalidateOptions) SetSchematics(schematics *SchematicsWorkspace) *PrevalidateOptions {
+	_options.Schematics = schematics
+	return _options
+}
+
+// SetPollInterval : Allow user to set PollInterval
+func (_options *PrevalidateOptions) SetPollInterval(pollInterval time.Duration) *PrevalidateOptions {
+	_options.PollInterval = pollInterval
+	return _options
+}
+
+// SetMaxPollInterval : Allow user to set MaxPollInterval
+func (_options *PrevalidateOptions) SetMaxPollInterval(maxPollInterval time.Duration) *PrevalidateOptions {
+	_options.MaxPollInterval = maxPollInterval
+	return _options
+}
+
+// SetBackoffFactor : Allow user to set BackoffFactor
+func (_options *PrevalidateOptions) SetBackoffFactor(backoffFactor float64) *PrevalidateOptions {
+	_options.BackoffFactor = backoffFactor
+	return _options
+}
+
+// SetTimeout : Allow user to set Timeout
+func (_options *PrevalidateOptions) SetTimeout(timeout time.Duration) *PrevalidateOptions {
+	_options.Timeout = timeout
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *PrevalidateOptions) SetHeaders(param map[string]string) *PrevalidateOptions {
+	options.Headers = param
+	return options
+}
+
+// PrevalidateResult : The outcome of a prevalidation: its job, its pre- and post-job, its cost estimate and its Code
+// Risk Analyzer logs.
+type PrevalidateResult struct {
+	// The result id of the prevalidation, to get it again with GetPrevalidate.
+	ResultID *string `json:"result_id,omitempty"`
+
+	PrevalidateGetResponse
+}
+
+// Succeeded returns true if the prevalidation succeeded.
+func (result *PrevalidateResult) Succeeded() bool {
+	return core.StringNilMapper(result.Result) == PrevalidateGetResponse_Result_Succeeded
+}
+
+// PrevalidateError : The error returned by Prevalidate when the prevalidation fails, or when the wait ends before it
+// finishes.
+type PrevalidateError struct {
+	// The last prevalidation that was retrieved, or nil if no poll completed.
+	Result *PrevalidateResult
+
+	// The reason the wait ended early, such as context.DeadlineExceeded. Nil when the prevalidation failed.
+	Err error
+}
+
+// Failed returns true if the prevalidation finished with a failed result.
+func (e *PrevalidateError) Failed() bool {
+	return e.Err == nil
+}
+
+// Error implements the error interface.
+func (e *PrevalidateError) Error() string {
+	resultID := ""
+	if e.Result != nil {
+		resultID = core.StringNilMapper(e.Result.ResultID)
+	}
+	if e.Failed() {
+		return fmt.Sprintf("the prevalidation '%s' failed", resultID)
+	}
+	return fmt.Sprintf("stopped waiting for the result '%s' of the prevalidation: %s", resultID, e.Err.Error())
+}
+
+// Unwrap returns the reason the wait ended early, if any.
+func (e *PrevalidateError) Unwrap() error {
+	return e.Err
+}
+
+// Prevalidate : Prevalidate a configuration definition and wait for the result
+// Submit the definition with CreatePrevalidate, then poll the result with GetPrevalidate until it is succeeded or
+// failed; an indeterminate result is polled again. The delay between polls starts at the poll interval and grows by
+// the backoff factor up to the maximum poll interval. If the prevalidation fails, the result is returned with a
+// PrevalidateError. If the context is done or the timeout expires first, a PrevalidateError that holds the last result
+// is returned.
+func (project *ProjectV1) Prevalidate(prevalidateOptions *PrevalidateOptions) (result *PrevalidateResult, response *core.DetailedResponse, err error) {
+	result, response, err = project.PrevalidateWithContext(context.Background(), prevalidateOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// PrevalidateWithContext is an alternate form of the Prevalidate method which supports a Context parameter
+func (project *ProjectV1) PrevalidateWithContext(ctx context.Context, prevalidateOptions *PrevalidateOptions) (result *PrevalidateResult, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(prevalidateOptions, "prevalidateOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(prevalidateOptions, "prevalidateOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	if prevalidateOptions.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, prevalidateOptions.Timeout)
+		defer cancel()
+	}
+
+	created, response, err := project.CreatePrevalidateWithContext(ctx, &CreatePrevalidateOptions{
+		ProjectID:  prevalidateOptions.ProjectID,
+		ID:         prevalidateOptions.ID,
+		Definition: prevalidateOptions.Definition,
+		Schematics: prevalidateOptions.Schematics,
+		Headers:    prevalidateOptions.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "create-prevalidate-error")
+		return
+	}
+	if created.ResultID == nil {
+		err = core.SDKErrorf(nil, "the prevalidation has no result id", "missing-result-id", common.GetComponentInfo())
+		return
+	}
+
+	backoff := newPollBackoff(prevalidateOptions.PollInterval, prevalidateOptions.MaxPollInterval, prevalidateOptions.BackoffFactor)
+	getPrevalidateOptions := &GetPrevalidateOptions{
+		ProjectID: prevalidateOptions.ProjectID,
+		ID:        prevalidateOptions.ID,
+		ResultID:  created.ResultID,
+		Headers:   prevalidateOptions.Headers,
+	}
+	last := &PrevalidateResult{ResultID: created.ResultID}
+	for {
+		err = backoff.wait(ctx)
+		if err != nil {
+			err = core.SDKErrorf(&PrevalidateError{Result: last, Err: err}, "", "wait-interrupted", common.GetComponentInfo())
+			return
+		}
+
+		var prevalidation *PrevalidateGetResponse
+		prevalidation, response, err = project.GetPrevalidateWithContext(ctx, getPrevalidateOptions)
+		if err != nil {
+			if ctx.Err() != nil {
+				err = core.SDKErrorf(&PrevalidateError{Result: last, Err: ctx.Err()}, "", "wait-interrupted", common.GetComponentInfo())
+				return
+			}
+			err = core.RepurposeSDKProblem(err, "get-prevalidate-error")
+			return
+		}
+		last = &PrevalidateResult{ResultID: created.ResultID, PrevalidateGetResponse: *prevalidation}
+
+		switch core.StringNilMapper(prevalidation.Result) {
+		case PrevalidateGetResponse_Result_Succeeded:
+			result = last
+			return
+		case PrevalidateGetResponse_Result_Failed:
+			result = last
+			err = core.SDKErrorf(&PrevalidateError{Result: last}, "", "prevalidate-failed", common.GetComponentInfo())
+			return
+		}
+	}
+}
@@ -0,0 +1,146 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/project-go-sdk/projectv1"
+	"github.com/IBM/project-go-sdk/projectv1fake"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Prevalidate`, func() {
+	var server *projectv1fake.Server
+	var projectService *projectv1.ProjectV1
+	var projectID string
+	var configID string
+
+	definition := &projectv1.ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype{Name: core.StringPtr("my-config")}
+
+	BeforeEach(func() {
+		var err error
+		server = projectv1fake.NewServer()
+		projectService, err = server.NewProjectV1()
+		Expect(err).To(BeNil())
+		project, _, err := projectService.CreateProject(projectService.NewCreateProjectOptions(
+			&projectv1.ProjectPrototypeDefinition{Name: core.StringPtr("my-project")}, "us-south", "Default"))
+		Expect(err).To(BeNil())
+		projectID = *project.ID
+		config, _, err := projectService.CreateConfig(projectService.NewCreateConfigOptions(projectID, definition))
+		Expect(err).To(BeNil())
+		configID = *config.ID
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	newOptions := func() *projectv1.PrevalidateOptions {
+		return projectService.NewPrevalidateOptions(projectID, configID, definition).
+			SetPollInterval(time.Millisecond).
+			SetMaxPollInterval(5 * time.Millisecond)
+	}
+
+	It(`Polls the result until the prevalidation finishes`, func() {
+		server.SetActionDelay(projectv1fake.ActionPrevalidate, 20*time.Millisecond)
+		server.SetCostEstimate(configID, &projectv1.ProjectConfigMetadataCostEstimate{Currency: core.StringPtr("USD"), TotalMonthlyCost: core.StringPtr("12.50")})
+		server.SetCraLogs(configID, &projectv1.ProjectConfigMetadataCodeRiskAnalyzerLogs{Status: core.StringPtr("passed")})
+
+		result, response, err := projectService.Prevalidate(newOptions())
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(200))
+		Expect(result.Succeeded()).To(BeTrue())
+		Expect(result.ResultID).ToNot(BeNil())
+		Expect(result.Job.ID).ToNot(BeNil())
+		Expect(*result.CostEstimate.TotalMonthlyCost).To(Equal("12.50"))
+		Expect(*result.CraLogs.Status).To(Equal("passed"))
+
+		again, _, err := projectService.GetPrevalidate(projectService.NewGetPrevalidateOptions(projectID, configID, *result.ResultID))
+		Expect(err).To(BeNil())
+		Expect(*again).To(Equal(result.PrevalidateGetResponse))
+	})
+	It(`Returns the result of a failed prevalidation with an error`, func() {
+		server.FailAction(configID, projectv1fake.ActionPrevalidate)
+
+		result, _, err := projectService.Prevalidate(newOptions())
+		Expect(err).ToNot(BeNil())
+		Expect(result.Succeeded()).To(BeFalse())
+		Expect(*result.Result).To(Equal(projectv1.PrevalidateGetResponse_Result_Failed))
+		var prevalidateErr *projectv1.PrevalidateError
+		Expect(errors.As(err, &prevalidateErr)).To(BeTrue())
+		Expect(prevalidateErr.Failed()).To(BeTrue())
+		Expect(prevalidateErr.Result).To(Equal(result))
+		Expect(err.Error()).To(Equal("the prevalidation '" + *result.ResultID + "' failed"))
+	})
+	It(`Stops waiting when the context is done`, func() {
+		server.SetActionDelay(projectv1fake.ActionPrevalidate, time.Hour)
+
+		result, _, err := projectService.Prevalidate(newOptions().SetTimeout(20 * time.Millisecond))
+		Expect(result).To(BeNil())
+		var prevalidateErr *projectv1.PrevalidateError
+		Expect(errors.As(err, &prevalidateErr)).To(BeTrue())
+		Expect(prevalidateErr.Failed()).To(BeFalse())
+		Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+		Expect(prevalidateErr.Result.ResultID).ToNot(BeNil())
+		Expect(prevalidateErr.Result.Result).To(BeNil())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, _, err = projectService.PrevalidateWithContext(ctx, newOptions())
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Returns the error of the requests`, func() {
+		_, _, err := projectService.Prevalidate(newOptions().SetID("unknown"))
+		Expect(err).ToNot(BeNil())
+
+		server.InjectError("POST", "/v1/projects/"+projectID+"/configs/"+configID+"/prevalidate", 500, "boom")
+		_, _, err = projectService.Prevalidate(newOptions())
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("boom"))
+	})
+	It(`Invoke Prevalidate with error: Param validation error`, func() {
+		_, _, err := projectService.Prevalidate(nil)
+		Expect(err).ToNot(BeNil())
+		_, _, err = projectService.Prevalidate(new(projectv1.PrevalidateOptions))
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Invoke NewPrevalidateOptions successfully`, func() {
+		options := projectService.NewPrevalidateOptions("testString", "testString", definition).
+			SetProjectID("project").
+			SetID("config").
+			SetDefinition(definition).
+			SetSchematics(&projectv1.SchematicsWorkspace{WorkspaceCrn: core.StringPtr("crn")}).
+			SetPollInterval(time.Second).
+			SetMaxPollInterval(time.Minute).
+			SetBackoffFactor(2).
+			SetTimeout(time.Hour).
+			SetHeaders(map[string]string{"foo": "bar"})
+		Expect(*options.ProjectID).To(Equal("project"))
+		Expect(*options.ID).To(Equal("config"))
+		Expect(options.Definition).To(Equal(definition))
+		Expect(*options.Schematics.WorkspaceCrn).To(Equal("crn"))
+		Expect(options.PollInterval).To(Equal(time.Second))
+		Expect(options.MaxPollInterval).To(Equal(time.Minute))
+		Expect(options.BackoffFactor).To(Equal(2.0))
+		Expect(options.Timeout).To(Equal(time.Hour))
+		Expect(options.Headers).To(Equal(map[string]string{"foo": "bar"}))
+	})
+})
@@ -0,0 +1,597 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/project-go-sdk/common"
+)
+
+// Constants associated with the InputReference.Collection property.
+// The collection of the project that the reference points into.
+const (
+	InputReference_Collection_Configs      = "configs"
+	InputReference_Collection_Environments = "environments"
+	InputReference_Collection_Projects     = "projects"
+)
+
+// Constants associated with the ResolvedReference.ProblemCode property.
+// The reason why a reference cannot be resolved.
+const (
+	ResolvedReference_ProblemCode_AmbiguousConfig    = "ambiguous_config"
+	ResolvedReference_ProblemCode_InvalidReference   = "invalid_reference"
+	ResolvedReference_ProblemCode_OutputNotAvailable = "output_not_available"
+	ResolvedReference_ProblemCode_UnknownConfig      = "unknown_config"
+	ResolvedReference_ProblemCode_UnknownEnvironment = "unknown_environment"
+	ResolvedReference_ProblemCode_UnknownOutput      = "unknown_output"
+	ResolvedReference_ProblemCode_UnknownProperty    = "unknown_property"
+)
+
+// InputReference : A reference from an input value to a value of another resource of the project, such as
+// "ref:../configs/network/outputs/vpc_id" or "ref:../environments/dev/inputs/region".
+// References are relative to the configuration that has the input, so "../configs/network" is the configuration
+// named network in the same project.
+type InputReference struct {
+	// The reference, with its "ref:" prefix.
+	Expression string `json:"expression"`
+
+	// The collection of the project that the reference points into.
+	Collection string `json:"collection"`
+
+	// The name of the configuration or environment, or the ID of a project.
+	Name string `json:"name"`
+
+	// The property of the resource, such as outputs, inputs or authorizations.
+	Property string `json:"property,omitempty"`
+
+	// The key of the value in the property, such as the name of an output. The segments of a nested key are
+	// separated by "/".
+	Key string `json:"key,omitempty"`
+}
+
+// IsInputReference returns true if an input value is a reference.
+func IsInputReference(value interface{}) bool {
+	expression, ok := value.(string)
+	return ok && strings.HasPrefix(expression, inputReferencePrefix)
+}
+
+// ParseInputReference : Parse an input reference
+// The path of the reference starts with "./", "../" or "/" segments, which are ignored, followed by the collection,
+// the name of the resource and the path of the value, such as "ref:../configs/network/outputs/vpc_id".
+func ParseInputReference(expression string) (*InputReference, error) {
+	if !strings.HasPrefix(expression, inputReferencePrefix) {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("'%s' is not a reference", expression), "invalid-reference", common.GetComponentInfo())
+	}
+	var segments []string
+	for _, segment := range strings.Split(strings.TrimPrefix(expression, inputReferencePrefix), "/") {
+		if len(segments) == 0 && (segment == "" || segment == "." || segment == "..") {
+			continue
+		}
+		segments = append(segments, segment)
+	}
+	if len(segments) < 2 || segments[1] == "" {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("reference '%s' has no resource name", expression), "invalid-reference", common.GetComponentInfo())
+	}
+	reference := &InputReference{
+		Expression: expression,
+		Collection: segments[0],
+		Name:       segments[1],
+	}
+	switch reference.Collection {
+	case InputReference_Collection_Configs, InputReference_Collection_Environments:
+		if len(segments) < 4 || segments[3] == "" {
+			return nil, core.SDKErrorf(nil, fmt.Sprintf("reference '%s' has no property and key", expression), "invalid-reference", common.GetComponentInfo())
+		}
+		reference.Property = segments[2]
+		reference.Key = strings.Join(segments[3:], "/")
+	case InputReference_Collection_Projects:
+		// A reference into another project keeps the rest of its path as the key.
+		reference.Key = strings.Join(segments[2:], "/")
+	default:
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("reference '%s' does not point into configs, environments or projects", expression), "invalid-reference", common.GetComponentInfo())
+	}
+	return reference, nil
+}
+
+// ResolveReferencesOptions : The ResolveReferences options.
+type ResolveReferencesOptions struct {
+	// The unique project ID.
+	ProjectID *string `json:"project_id" validate:"required,ne="`
+
+	// The IDs of the configurations whose inputs are resolved. If not set, the inputs of all the configurations of the
+	// project are resolved.
+	ConfigIds []string `json:"config_ids,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewResolveReferencesOptions : Instantiate ResolveReferencesOptions
+func (*ProjectV1) NewResolveReferencesOptions(projectID string) *ResolveReferencesOptions {
+	return &ResolveReferencesOptions{
+		ProjectID: core.StringPtr(projectID),
+	}
+}
+
+// SetProjectID : Allow user to set ProjectID
+func (_options *ResolveReferencesOptions) SetProjectID(projectID string) *ResolveReferencesOptions {
+	_options.ProjectID = core.StringPtr(projectID)
+	return _options
+}
+
+// SetConfigIds : Allow user to set ConfigIds
+func (_options *ResolveReferencesOptions) SetConfigIds(configIds []string) *ResolveReferencesOptions {
+	_options.ConfigIds = configIds
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *ResolveReferencesOptions) SetHeaders(param map[string]string) *ResolveReferencesOptions {
+	options.Headers = param
+	return options
+}
+
+// ResolvedReference : A reference of a configuration input and the value that it resolves to.
+type ResolvedReference struct {
+	// The ID of the configuration that has the input.
+	ConfigID string `json:"config_id"`
+
+	// The name of the configuration that has the input.
+	ConfigName string `json:"config_name"`
+
+	// The name of the input. The segments of the path of a reference that is nested in a list or an object value are
+	// separated by "/".
+	Input string `json:"input"`
+
+	// The reference.
+	Reference InputReference `json:"reference"`
+
+	// The ID of the configuration or environment that the reference points to.
+	TargetID string `json:"target_id,omitempty"`
+
+	// Whether the reference was resolved. References into other projects are not resolved.
+	Resolved bool `json:"resolved"`
+
+	// The value that the reference resolves to.
+	Value interface{} `json:"value,omitempty"`
+
+	// Whether the value is sensitive.
+	Sensitive bool `json:"sensitive,omitempty"`
+
+	// The reason why the reference cannot be resolved.
+	ProblemCode string `json:"problem_code,omitempty"`
+
+	// The description of the problem.
+	Problem string `json:"problem,omitempty"`
+}
+
+// InputReferenceError : The error that ResolveReferences returns when references cannot be resolved.
+type InputReferenceError struct {
+	// The references that cannot be resolved.
+	Problems []ResolvedReference
+}
+
+// Error returns the descriptions of the problems.
+func (e *InputReferenceError) Error() string {
+	messages := make([]string, len(e.Problems))
+	for i, problem := range e.Problems {
+		messages[i] = fmt.Sprintf("input '%s' of configuration '%s': %s", problem.Input, problem.ConfigName, problem.Problem)
+	}
+	return "references cannot be resolved: " + strings.Join(messages, "; ")
+}
+
+// ReferenceResolution : The references of the inputs of the configurations of a project.
+type ReferenceResolution struct {
+	// The unique project ID.
+	ProjectID string `json:"project_id"`
+
+	// The references, by configuration and then by input name.
+	References []ResolvedReference `json:"references"`
+
+	configs []*ProjectConfig
+	inputs  map[string]map[string]interface{}
+}
+
+// Problems returns the references that cannot be resolved.
+func (resolution *ReferenceResolution) Problems() []ResolvedReference {
+	var problems []ResolvedReference
+	for _, reference := range resolution.References {
+		if reference.ProblemCode != "" {
+			problems = append(problems, reference)
+		}
+	}
+	return problems
+}
+
+// DependsOn returns the IDs of the configurations whose outputs the inputs of a configuration refer to.
+func (resolution *ReferenceResolution) DependsOn(configID string) []string {
+	var dependsOn []string
+	for _, reference := range resolution.References {
+		if reference.ConfigID == configID && reference.Reference.Collection == InputReference_Collection_Configs &&
+			reference.TargetID != "" && !containsString(dependsOn, reference.TargetID) {
+			dependsOn = append(dependsOn, reference.TargetID)
+		}
+	}
+	return dependsOn
+}
+
+// Graph returns the dependency graph of the resolved configurations, in which a configuration depends on the
+// configurations that its inputs refer to. Its Order is an order in which they can be deployed. A StackCycleError is
+// returned if the references form a cycle.
+func (resolution *ReferenceResolution) Graph() (*StackGraph, error) {
+	members := make([]StackMember, 0, len(resolution.configs))
+	dependsOn := make(map[string][]string)
+	for _, config := range resolution.configs {
+		members = append(members, StackMember{
+			Name:     core.StringPtr(configName(config)),
+			ConfigID: config.ID,
+		})
+		dependsOn[*config.ID] = resolution.DependsOn(*config.ID)
+	}
+	return NewStackGraph(members, dependsOn)
+}
+
+// Preview returns the inputs of a configuration with the references that were resolved replaced with their values.
+// Values that are sensitive are replaced too, so the preview must be handled as a secret.
+func (resolution *ReferenceResolution) Preview(configID string) map[string]interface{} {
+	inputs, found := resolution.inputs[configID]
+	if !found {
+		return nil
+	}
+	values := make(map[string]interface{})
+	for _, reference := range resolution.References {
+		if reference.ConfigID == configID && reference.Resolved {
+			values[reference.Input] = reference.Value
+		}
+	}
+	preview := make(map[string]interface{}, len(inputs))
+	for name, value := range inputs {
+		preview[name] = previewValue(name, value, values)
+	}
+	return preview
+}
+
+// previewValue returns a copy of an input value with its resolved references replaced.
+func previewValue(path string, value interface{}, values map[string]interface{}) interface{} {
+	switch v := value.(type) {
+	case string:
+		if resolved, found := values[path]; found {
+			return resolved
+		}
+	case []interface{}:
+		items := make([]interface{}, len(v))
+		for i, item := range v {
+			items[i] = previewValue(path+"/"+strconv.Itoa(i), item, values)
+		}
+		return items
+	case map[string]interface{}:
+		object := make(map[string]interface{}, len(v))
+		for name, property := range v {
+			object[name] = previewValue(path+"/"+name, property, values)
+		}
+		return object
+	}
+	return value
+}
+
+// ResolveReferences : Resolve the input references of the configurations of a project
+// Find the references in the inputs of the configurations and check that the configurations, environments, outputs
+// and inputs that they point to exist. A reference resolves to the value that the service resolved it to in the
+// references of its configuration, and otherwise to the outputs of the configuration or the definition of the
+// environment that it points to. Outputs exist once a configuration is deployed: a reference to a configuration that
+// has no outputs yet has the output_not_available problem. When configurations share a name, a reference to the name
+// points to the one in the environment of the configuration that has the input, and has the ambiguous_config problem
+// if there is not exactly one. The resolution previews the values of the inputs and orders the configurations by their
+// references. If references cannot be resolved, the resolution is returned with an *InputReferenceError.
+func (project *ProjectV1) ResolveReferences(resolveReferencesOptions *ResolveReferencesOptions) (result *ReferenceResolution, err error) {
+	result, err = project.ResolveReferencesWithContext(context.Background(), resolveReferencesOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ResolveReferencesWithContext is an alternate form of the ResolveReferences method which supports a Context parameter
+func (project *ProjectV1) ResolveReferencesWithContext(ctx context.Context, resolveReferencesOptions *ResolveReferencesOptions) (result *ReferenceResolution, err error) {
+	err = core.ValidateNotNil(resolveReferencesOptions, "resolveReferencesOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(resolveReferencesOptions, "resolveReferencesOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	projectID := resolveReferencesOptions.ProjectID
+	headers := resolveReferencesOptions.Headers
+
+	environmentsPager, err := project.NewProjectEnvironmentsPager(&ListProjectEnvironmentsOptions{ProjectID: projectID, Headers: headers})
+	if err != nil {
+		return
+	}
+	environments, err := environmentsPager.GetAllWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-environments-error")
+		return
+	}
+	configsPager, err := project.NewConfigsPager(&ListConfigsOptions{ProjectID: projectID, Headers: headers})
+	if err != nil {
+		return
+	}
+	summaries, err := configsPager.GetAllWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-configs-error")
+		return
+	}
+	resolver := &referenceResolver{
+		configs:      make(map[string][]*ProjectConfig),
+		environments: make(map[string]*Environment),
+	}
+	configs := make([]*ProjectConfig, 0, len(summaries))
+	for _, summary := range summaries {
+		var config *ProjectConfig
+		config, _, err = project.GetConfigWithContext(ctx, &GetConfigOptions{ProjectID: projectID, ID: summary.ID, Headers: headers})
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "get-config-error")
+			return
+		}
+		configs = append(configs, config)
+		resolver.configs[configName(config)] = append(resolver.configs[configName(config)], config)
+	}
+	for i := range environments {
+		if environments[i].Definition != nil {
+			resolver.environments[core.StringNilMapper(environments[i].Definition.Name)] = &environments[i]
+		}
+	}
+
+	result = &ReferenceResolution{
+		ProjectID:  *projectID,
+		References: []ResolvedReference{},
+		inputs:     make(map[string]map[string]interface{}),
+	}
+	for _, config := range configs {
+		if len(resolveReferencesOptions.ConfigIds) > 0 && !containsString(resolveReferencesOptions.ConfigIds, *config.ID) {
+			continue
+		}
+		result.configs = append(result.configs, config)
+		var inputs map[string]interface{}
+		if definition := definitionResponse(config.Definition); definition != nil {
+			inputs = definition.Inputs
+		}
+		result.inputs[*config.ID] = inputs
+		names := make([]string, 0, len(inputs))
+		for name := range inputs {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			resolver.resolveValue(config, name, inputs[name], &result.References)
+		}
+	}
+
+	if problems := result.Problems(); len(problems) > 0 {
+		err = core.SDKErrorf(&InputReferenceError{Problems: problems}, "", "unresolved-references", common.GetComponentInfo())
+	}
+	return
+}
+
+// referenceResolver resolves references with the configurations and environments of a project, by name.
+type referenceResolver struct {
+	configs      map[string][]*ProjectConfig
+	environments map[string]*Environment
+}
+
+// configName returns the name of a configuration, or "" if its definition is not known.
+func configName(config *ProjectConfig) string {
+	if definition := definitionResponse(config.Definition); definition != nil {
+		return core.StringNilMapper(definition.Name)
+	}
+	return ""
+}
+
+// configEnvironmentID returns the ID of the environment of a configuration, or "" if it has none.
+func configEnvironmentID(config *ProjectConfig) string {
+	if definition := definitionResponse(config.Definition); definition != nil {
+		return core.StringNilMapper(definition.EnvironmentID)
+	}
+	return ""
+}
+
+// findConfig returns the configurations that a reference from a configuration to a name can point to. When several
+// configurations have the name, only those in the environment of the configuration are returned.
+func (resolver *referenceResolver) findConfig(from *ProjectConfig, name string) []*ProjectConfig {
+	candidates := resolver.configs[name]
+	if len(candidates) <= 1 {
+		return candidates
+	}
+	var matches []*ProjectConfig
+	for _, candidate := range candidates {
+		if configEnvironmentID(candidate) == configEnvironmentID(from) {
+			matches = append(matches, candidate)
+		}
+	}
+	if len(matches) == 0 {
+		return candidates
+	}
+	return matches
+}
+
+// resolveValue appends the resolutions of the references in an input value, which can be nested in lists and objects.
+func (resolver *referenceResolver) resolveValue(config *ProjectConfig, path string, value interface{}, references *[]ResolvedReference) {
+	switch v := value.(type) {
+	case string:
+		if IsInputReference(v) {
+			resolved := ResolvedReference{
+				ConfigID:   *config.ID,
+				ConfigName: configName(config),
+				Input:      path,
+			}
+			resolver.resolve(config, v, &resolved)
+			*references = append(*references, resolved)
+		}
+	case []interface{}:
+		for i, item := range v {
+			resolver.resolveValue(config, path+"/"+strconv.Itoa(i), item, references)
+		}
+	case map[string]interface{}:
+		names := make([]string, 0, len(v))
+		for name := range v {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			resolver.resolveValue(config, path+"/"+name, v[name], references)
+		}
+	}
+}
+
+func (resolver *referenceResolver) resolve(config *ProjectConfig, expression string, resolved *ResolvedReference) {
+	problem := func(code string, format string, a ...interface{}) {
+		resolved.ProblemCode = code
+		resolved.Problem = fmt.Sprintf(format, a...)
+	}
+
+	reference, err := ParseInputReference(expression)
+	if err != nil {
+		resolved.Reference = InputReference{Expression: expression}
+		problem(ResolvedReference_ProblemCode_InvalidReference, "%s", err.Error())
+		return
+	}
+	resolved.Reference = *reference
+
+	switch reference.Collection {
+	case InputReference_Collection_Configs:
+		targets := resolver.findConfig(config, reference.Name)
+		if len(targets) == 0 {
+			problem(ResolvedReference_ProblemCode_UnknownConfig, "configuration '%s' does not exist", reference.Name)
+			return
+		}
+		if len(targets) > 1 {
+			problem(ResolvedReference_ProblemCode_AmbiguousConfig, "%d configurations are named '%s'", len(targets), reference.Name)
+			return
+		}
+		target := targets[0]
+		resolved.TargetID = *target.ID
+		if resolveReferenced(config, resolved) {
+			resolved.Sensitive = resolved.Sensitive || reference.Property == "authorizations" || isSensitiveOutput(target, reference)
+			return
+		}
+		if reference.Property != "outputs" {
+			resolveProperty(definitionProperties(definitionResponse(target.Definition)), reference, resolved, problem)
+			return
+		}
+		if len(target.Outputs) == 0 {
+			problem(ResolvedReference_ProblemCode_OutputNotAvailable, "configuration '%s' has no outputs yet", reference.Name)
+			return
+		}
+		for _, output := range target.Outputs {
+			if core.StringNilMapper(output.Name) == reference.Key {
+				resolved.Resolved = true
+				resolved.Value = output.Value
+				resolved.Sensitive = output.Sensitive != nil && *output.Sensitive
+				return
+			}
+		}
+		problem(ResolvedReference_ProblemCode_UnknownOutput, "configuration '%s' has no output '%s'", reference.Name, reference.Key)
+	case InputReference_Collection_Environments:
+		target, found := resolver.environments[reference.Name]
+		if !found {
+			problem(ResolvedReference_ProblemCode_UnknownEnvironment, "environment '%s' does not exist", reference.Name)
+			return
+		}
+		resolved.TargetID = *target.ID
+		if resolveReferenced(config, resolved) {
+			resolved.Sensitive = resolved.Sensitive || reference.Property == "authorizations"
+			return
+		}
+		resolveProperty(definitionProperties(target.Definition), reference, resolved, problem)
+	}
+}
+
+// resolveReferenced resolves a reference of a configuration to the value that the service resolved it to, in the
+// references of the configuration. It returns false if the service did not resolve the reference.
+func resolveReferenced(config *ProjectConfig, resolved *ResolvedReference) bool {
+	value, sensitive, found := referencedValue(config, resolved.Reference.Expression)
+	if !found {
+		return false
+	}
+	resolved.Resolved = true
+	resolved.Value = value
+	resolved.Sensitive = sensitive
+	return true
+}
+
+// referencedValue returns the value that the service resolved a reference expression of a configuration to, from the
+// references of the configuration, and whether the value is sensitive. The references are keyed by expression, and an
+// entry is either the value or an object with the value in its value property. The last result is false if the
+// service did not resolve the expression.
+func referencedValue(config *ProjectConfig, expression string) (value interface{}, sensitive bool, found bool) {
+	if config == nil || config.References == nil {
+		return
+	}
+	value = config.References.GetProperty(expression)
+	if object, ok := value.(map[string]interface{}); ok {
+		if _, found := object["value"]; found {
+			value = object["value"]
+			sensitive, _ = object["sensitive"].(bool)
+		}
+	}
+	if value == nil {
+		return nil, false, false
+	}
+	return value, sensitive, true
+}
+
+// isSensitiveOutput returns true if a reference points to an output of a configuration that is sensitive.
+func isSensitiveOutput(target *ProjectConfig, reference *InputReference) bool {
+	if reference.Property != "outputs" {
+		return false
+	}
+	for _, output := range target.Outputs {
+		if core.StringNilMapper(output.Name) == reference.Key {
+			return output.Sensitive != nil && *output.Sensitive
+		}
+	}
+	return false
+}
+
+// resolveProperty resolves a reference to a value of the definition of a configuration or an environment.
+func resolveProperty(properties map[string]interface{}, reference *InputReference, resolved *ResolvedReference,
+	problem func(code string, format string, a ...interface{})) {
+	var value interface{} = properties[reference.Property]
+	for _, segment := range strings.Split(reference.Key, "/") {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			value = nil
+			break
+		}
+		value = object[segment]
+	}
+	if value == nil {
+		problem(ResolvedReference_ProblemCode_UnknownProperty, "%s '%s' has no %s '%s'",
+			strings.TrimSuffix(reference.Collection, "s"), reference.Name, reference.Property, reference.Key)
+		return
+	}
+	resolved.Resolved = true
+	resolved.Value = value
+	resolved.Sensitive = reference.Property == "authorizations"
+}
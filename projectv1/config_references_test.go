@@ -0,0 +1,242 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1_test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/project-go-sdk/projectv1"
+	"github.com/IBM/project-go-sdk/projectv1fake"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ParseInputReference`, func() {
+	It(`Parses references to configurations and environments`, func() {
+		reference, err := projectv1.ParseInputReference("ref:../configs/network/outputs/vpc_id")
+		Expect(err).To(BeNil())
+		Expect(*reference).To(Equal(projectv1.InputReference{
+			Expression: "ref:../configs/network/outputs/vpc_id",
+			Collection: projectv1.InputReference_Collection_Configs,
+			Name:       "network",
+			Property:   "outputs",
+			Key:        "vpc_id",
+		}))
+
+		reference, err = projectv1.ParseInputReference("ref:./environments/dev/inputs/tags/team")
+		Expect(err).To(BeNil())
+		Expect(reference.Collection).To(Equal(projectv1.InputReference_Collection_Environments))
+		Expect(reference.Key).To(Equal("tags/team"))
+
+		reference, err = projectv1.ParseInputReference("ref:/projects/other/configs/network/outputs/vpc_id")
+		Expect(err).To(BeNil())
+		Expect(reference.Collection).To(Equal(projectv1.InputReference_Collection_Projects))
+		Expect(reference.Key).To(Equal("configs/network/outputs/vpc_id"))
+	})
+	It(`Rejects malformed references`, func() {
+		for _, expression := range []string{"network", "ref:", "ref:../configs/network", "ref:../configs/network/outputs", "ref:../stacks/network/outputs/id"} {
+			_, err := projectv1.ParseInputReference(expression)
+			Expect(err).ToNot(BeNil(), expression)
+		}
+		Expect(projectv1.IsInputReference("ref:../configs/network/outputs/vpc_id")).To(BeTrue())
+		Expect(projectv1.IsInputReference(1)).To(BeFalse())
+	})
+})
+
+var _ = Describe(`ResolveReferences`, func() {
+	var server *projectv1fake.Server
+	var projectService *projectv1.ProjectV1
+	var projectID string
+	var networkID string
+	var appID string
+
+	createConfig := func(name string, inputs map[string]interface{}) string {
+		config, _, err := projectService.CreateConfig(projectService.NewCreateConfigOptions(projectID,
+			&projectv1.ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype{
+				Name:   core.StringPtr(name),
+				Inputs: inputs,
+			}))
+		Expect(err).To(BeNil())
+		return *config.ID
+	}
+
+	BeforeEach(func() {
+		var err error
+		server = projectv1fake.NewServer()
+		projectService, err = server.NewProjectV1()
+		Expect(err).To(BeNil())
+
+		project, _, err := projectService.CreateProject(projectService.NewCreateProjectOptions(
+			&projectv1.ProjectPrototypeDefinition{Name: core.StringPtr("my-project")}, "us-south", "Default"))
+		Expect(err).To(BeNil())
+		projectID = *project.ID
+		_, _, err = projectService.CreateProjectEnvironment(projectService.NewCreateProjectEnvironmentOptions(projectID,
+			&projectv1.EnvironmentDefinitionRequiredProperties{
+				Name:   core.StringPtr("dev"),
+				Inputs: map[string]interface{}{"region": "us-south"},
+				Authorizations: &projectv1.ProjectConfigAuth{
+					Method: core.StringPtr("api_key"),
+					ApiKey: core.StringPtr("secret-api-key"),
+				},
+			}))
+		Expect(err).To(BeNil())
+
+		appID = createConfig("app", map[string]interface{}{
+			"vpc_id":  "ref:../configs/network/outputs/vpc_id",
+			"subnets": []interface{}{"ref:../configs/network/outputs/subnet_id", "fixed"},
+			"api_key": "ref:../environments/dev/authorizations/api_key",
+			"name":    "app",
+		})
+		networkID = createConfig("network", map[string]interface{}{
+			"region": "ref:../environments/dev/inputs/region",
+		})
+		server.SetDeployOutputs(networkID, []projectv1.OutputValue{
+			{Name: core.StringPtr("vpc_id"), Value: "r006-1234"},
+			{Name: core.StringPtr("subnet_id"), Value: "0717-5678"},
+		})
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Reports references to outputs that are not available`, func() {
+		resolution, err := projectService.ResolveReferences(projectService.NewResolveReferencesOptions(projectID))
+		var referenceErr *projectv1.InputReferenceError
+		Expect(errors.As(err, &referenceErr)).To(BeTrue())
+		Expect(referenceErr.Problems).To(HaveLen(2))
+		Expect(referenceErr.Problems[0].Input).To(Equal("subnets/0"))
+		Expect(referenceErr.Problems[0].ProblemCode).To(Equal(projectv1.ResolvedReference_ProblemCode_OutputNotAvailable))
+		Expect(referenceErr.Problems[1].Input).To(Equal("vpc_id"))
+		Expect(err.Error()).To(ContainSubstring("configuration 'network' has no outputs yet"))
+
+		Expect(resolution.References).To(HaveLen(4))
+		Expect(resolution.DependsOn(appID)).To(Equal([]string{networkID}))
+		graph, err := resolution.Graph()
+		Expect(err).To(BeNil())
+		Expect(graph.Order()).To(Equal([]string{networkID, appID}))
+		Expect(resolution.Preview(networkID)).To(Equal(map[string]interface{}{"region": "us-south"}))
+	})
+	It(`Resolves the references to deployed outputs`, func() {
+		_, err := projectService.RolloutConfig(projectService.NewRolloutConfigOptions(projectID, networkID).
+			SetPollInterval(time.Millisecond).
+			SetMaxPollInterval(time.Millisecond))
+		Expect(err).To(BeNil())
+
+		resolution, err := projectService.ResolveReferences(projectService.NewResolveReferencesOptions(projectID).
+			SetConfigIds([]string{appID}))
+		Expect(err).To(BeNil())
+		Expect(resolution.References).To(HaveLen(3))
+		Expect(resolution.References[0].Input).To(Equal("api_key"))
+		Expect(resolution.References[0].Sensitive).To(BeTrue())
+		Expect(resolution.Preview(appID)).To(Equal(map[string]interface{}{
+			"vpc_id":  "r006-1234",
+			"subnets": []interface{}{"0717-5678", "fixed"},
+			"api_key": "secret-api-key",
+			"name":    "app",
+		}))
+		Expect(resolution.Preview(networkID)).To(BeNil())
+	})
+	It(`Resolves the references that the service resolved`, func() {
+		references := &projectv1.ReferenceValue{}
+		references.SetProperty("ref:../configs/network/outputs/vpc_id", map[string]interface{}{"value": "r006-9999", "sensitive": true})
+		references.SetProperty("ref:../configs/network/outputs/subnet_id", "0717-9999")
+		server.SetReferences(appID, references)
+
+		resolution, err := projectService.ResolveReferences(projectService.NewResolveReferencesOptions(projectID).
+			SetConfigIds([]string{appID}))
+		Expect(err).To(BeNil())
+		Expect(resolution.References[2].Input).To(Equal("vpc_id"))
+		Expect(resolution.References[2].TargetID).To(Equal(networkID))
+		Expect(resolution.References[2].Sensitive).To(BeTrue())
+		Expect(resolution.Preview(appID)).To(Equal(map[string]interface{}{
+			"vpc_id":  "r006-9999",
+			"subnets": []interface{}{"0717-9999", "fixed"},
+			"api_key": "secret-api-key",
+			"name":    "app",
+		}))
+	})
+	It(`Resolves a name that configurations share in the environment of the reference`, func() {
+		environment, _, err := projectService.CreateProjectEnvironment(projectService.NewCreateProjectEnvironmentOptions(projectID,
+			&projectv1.EnvironmentDefinitionRequiredProperties{Name: core.StringPtr("prod")}))
+		Expect(err).To(BeNil())
+		createInEnvironment := func(name string, inputs map[string]interface{}) string {
+			config, _, err := projectService.CreateConfig(projectService.NewCreateConfigOptions(projectID,
+				&projectv1.ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype{
+					Name:          core.StringPtr(name),
+					EnvironmentID: environment.ID,
+					Inputs:        inputs,
+				}))
+			Expect(err).To(BeNil())
+			return *config.ID
+		}
+		prodNetworkID := createInEnvironment("network", nil)
+		prodAppID := createInEnvironment("app", map[string]interface{}{"vpc_id": "ref:../configs/network/outputs/vpc_id"})
+		server.SetDeployOutputs(prodNetworkID, []projectv1.OutputValue{{Name: core.StringPtr("vpc_id"), Value: "r006-prod"}})
+		_, err = projectService.RolloutConfig(projectService.NewRolloutConfigOptions(projectID, prodNetworkID).
+			SetPollInterval(time.Millisecond).
+			SetMaxPollInterval(time.Millisecond))
+		Expect(err).To(BeNil())
+
+		resolution, err := projectService.ResolveReferences(projectService.NewResolveReferencesOptions(projectID).
+			SetConfigIds([]string{prodAppID}))
+		Expect(err).To(BeNil())
+		Expect(resolution.DependsOn(prodAppID)).To(Equal([]string{prodNetworkID}))
+		Expect(resolution.Preview(prodAppID)).To(Equal(map[string]interface{}{"vpc_id": "r006-prod"}))
+
+		createConfig("network", nil)
+		resolution, err = projectService.ResolveReferences(projectService.NewResolveReferencesOptions(projectID).
+			SetConfigIds([]string{appID}))
+		var referenceErr *projectv1.InputReferenceError
+		Expect(errors.As(err, &referenceErr)).To(BeTrue())
+		Expect(referenceErr.Problems).To(HaveLen(2))
+		Expect(referenceErr.Problems[0].ProblemCode).To(Equal(projectv1.ResolvedReference_ProblemCode_AmbiguousConfig))
+		Expect(err.Error()).To(ContainSubstring("2 configurations are named 'network'"))
+		Expect(resolution.DependsOn(appID)).To(BeEmpty())
+	})
+	It(`Reports references to missing configurations, outputs and properties`, func() {
+		createConfig("broken", map[string]interface{}{
+			"a": "ref:../configs/missing/outputs/id",
+			"b": "ref:../environments/prod/inputs/region",
+			"c": "ref:../environments/dev/inputs/zone",
+			"d": "ref:../outputs",
+			"e": "ref:/projects/other/configs/network/outputs/vpc_id",
+		})
+		_, err := projectService.RolloutConfig(projectService.NewRolloutConfigOptions(projectID, networkID).
+			SetPollInterval(time.Millisecond).
+			SetMaxPollInterval(time.Millisecond))
+		Expect(err).To(BeNil())
+		createConfig("other", map[string]interface{}{
+			"f": "ref:../configs/network/outputs/missing",
+		})
+
+		resolution, err := projectService.ResolveReferences(projectService.NewResolveReferencesOptions(projectID))
+		Expect(err).ToNot(BeNil())
+		codes := map[string]string{}
+		for _, problem := range resolution.Problems() {
+			codes[problem.Input] = problem.ProblemCode
+		}
+		Expect(codes).To(Equal(map[string]string{
+			"a": projectv1.ResolvedReference_ProblemCode_UnknownConfig,
+			"b": projectv1.ResolvedReference_ProblemCode_UnknownEnvironment,
+			"c": projectv1.ResolvedReference_ProblemCode_UnknownProperty,
+			"d": projectv1.ResolvedReference_ProblemCode_InvalidReference,
+			"f": projectv1.ResolvedReference_ProblemCode_UnknownOutput,
+		}))
+	})
+})
@@ -0,0 +1,408 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/project-go-sdk/common"
+)
+
+// Constants associated with the RolloutError.Stage property.
+// The rollout stage that did not complete.
+const (
+	RolloutError_Stage_Approve  = "approve"
+	RolloutError_Stage_Deploy   = "deploy"
+	RolloutError_Stage_Validate = "validate"
+)
+
+// RolloutConfigOptions : The RolloutConfig options.
+type RolloutConfigOptions struct {
+	// The unique project ID.
+	ProjectID *string `json:"project_id" validate:"required,ne="`
+
+	// The unique configuration ID.
+	ID *string `json:"id" validate:"required,ne="`
+
+	// Notes on the approval. Required when Force is true.
+	Comment *string `json:"comment,omitempty"`
+
+	// Whether to force approve the draft. A forced rollout also continues past a failed validation.
+	Force *bool `json:"force,omitempty"`
+
+	// The delay between the first polls of the configuration state. See WaitForConfigStateOptions.PollInterval.
+	PollInterval time.Duration `json:"poll_interval,omitempty"`
+
+	// The upper bound for the delay between polls. See WaitForConfigStateOptions.MaxPollInterval.
+	MaxPollInterval time.Duration `json:"max_poll_interval,omitempty"`
+
+	// The factor by which the delay between polls grows. See WaitForConfigStateOptions.BackoffFactor.
+	BackoffFactor float64 `json:"backoff_factor,omitempty"`
+
+	// The Code Risk Analyzer thresholds that the validated configuration must meet before it is approved, even when the
+	// rollout is forced. If it is not set, the results are not checked.
+	ComplianceGate *ComplianceGate `json:"compliance_gate,omitempty"`
+
+	// The maximum time to wait for the validation to finish. A zero value waits until the context is done.
+	ValidateTimeout time.Duration `json:"validate_timeout,omitempty"`
+
+	// The maximum time to wait for the deployment to finish. A zero value waits until the context is done.
+	DeployTimeout time.Duration `json:"deploy_timeout,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewRolloutConfigOptions : Instantiate RolloutConfigOptions
+func (*ProjectV1) NewRolloutConfigOptions(projectID string, id string) *RolloutConfigOptions {
+	return &RolloutConfigOptions{
+		ProjectID: core.StringPtr(projectID),
+		ID:        core.StringPtr(id),
+	}
+}
+
+// SetProjectID : Allow user to set ProjectID
+func (_options *RolloutConfigOptions) SetProjectID(projectID string) *RolloutConfigOptions {
+	_options.ProjectID = core.StringPtr(projectID)
+	return _options
+}
+
+// SetID : Allow user to set ID
+func (_options *RolloutConfigOptions) SetID(id string) *RolloutConfigOptions {
+	_options.ID = core.StringPtr(id)
+	return _options
+}
+
+// SetComment : Allow user to set Comment
+func (_options *RolloutConfigOptions) SetComment(comment string) *RolloutConfigOptions {
+	_options.Comment = core.StringPtr(comment)
+	return _options
+}
+
+// SetForce : Allow user to set Force
+func (_options *RolloutConfigOptions) SetForce(force bool) *RolloutConfigOptions {
+	_options.Force = core.BoolPtr(force)
+	return _options
+}
+
+// SetPollInterval : Allow user to set PollInterval
+func (_options *RolloutConfigOptions) SetPollInterval(pollInterval time.Duration) *RolloutConfigOptions {
+	_options.PollInterval = pollInterval
+	return _options
+}
+
+// SetMaxPollInterval : Allow user to set MaxPollInterval
+func (_options *RolloutConfigOptions) SetMaxPollInterval(maxPollInterval time.Duration) *RolloutConfigOptions {
+	_options.MaxPollInterval = maxPollInterval
+	return _options
+}
+
+// SetBackoffFactor : Allow user to set BackoffFactor
+func (_options *RolloutConfigOptions) SetBackoffFactor(backoffFactor float64) *RolloutConfigOptions {
+	_options.BackoffFactor = backoffFactor
+	return _options
+}
+
+// SetComplianceGate : Allow user to set ComplianceGate
+func (_options *RolloutConfigOptions) SetComplianceGate(complianceGate *ComplianceGate) *RolloutConfigOptions {
+	_options.ComplianceGate = complianceGate
+	return _options
+}
+
+// SetValidateTimeout : Allow user to set ValidateTimeout
+func (_options *RolloutConfigOptions) SetValidateTimeout(validateTimeout time.Duration) *RolloutConfigOptions {
+	_options.ValidateTimeout = validateTimeout
+	return _options
+}
+
+// SetDeployTimeout : Allow user to set DeployTimeout
+func (_options *RolloutConfigOptions) SetDeployTimeout(deployTimeout time.Duration) *RolloutConfigOptions {
+	_options.DeployTimeout = deployTimeout
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *RolloutConfigOptions) SetHeaders(param map[string]string) *RolloutConfigOptions {
+	options.Headers = param
+	return options
+}
+
+// RolloutReport : The outcome of a configuration rollout. Fields are filled in as the rollout progresses, so a report
+// that is returned with an error describes the stages that completed.
+type RolloutReport struct {
+	// The unique project ID.
+	ProjectID string `json:"project_id"`
+
+	// The unique configuration ID.
+	ConfigID string `json:"config_id"`
+
+	// The configuration version that was approved.
+	Version *int64 `json:"version,omitempty"`
+
+	// Whether the draft was force approved.
+	Forced bool `json:"forced"`
+
+	// The last validation of the configuration.
+	LastValidated *LastValidatedActionWithSummary `json:"last_validated,omitempty"`
+
+	// The summary of the validation job.
+	ValidationSummary *ActionJobSummary `json:"validation_summary,omitempty"`
+
+	// The verdict of the compliance gate, if one was checked.
+	ComplianceVerdict *ComplianceVerdict `json:"compliance_verdict,omitempty"`
+
+	// The last deployment of the configuration.
+	LastDeployed *LastActionWithSummary `json:"last_deployed,omitempty"`
+
+	// The summary of the deployment job.
+	DeploymentSummary *ActionJobSummary `json:"deployment_summary,omitempty"`
+
+	// The configuration as it was last retrieved.
+	Config *ProjectConfig `json:"config,omitempty"`
+}
+
+// RolloutError : The error returned by RolloutConfig when a stage of the rollout does not complete.
+type RolloutError struct {
+	// The stage that did not complete.
+	Stage string
+
+	// The state code of the configuration when the rollout stopped, if known. One of the ProjectConfig_StateCode_*
+	// values.
+	StateCode string
+
+	// The underlying error.
+	Err error
+}
+
+// Blocked returns true if the configuration cannot proceed until a user provides missing inputs or another
+// configuration it depends on is deployed.
+func (e *RolloutError) Blocked() bool {
+	return isBlockingStateCode(e.StateCode)
+}
+
+// Error implements the error interface.
+func (e *RolloutError) Error() string {
+	if e.Blocked() {
+		return fmt.Sprintf("rollout stopped at %s: configuration is %s: %s", e.Stage, e.StateCode, e.Err.Error())
+	}
+	return fmt.Sprintf("rollout stopped at %s: %s", e.Stage, e.Err.Error())
+}
+
+// Unwrap returns the underlying error.
+func (e *RolloutError) Unwrap() error {
+	return e.Err
+}
+
+// GetConsoleMessage implements the core.Problem interface. A RolloutError is a problem so that the SDK problem that
+// wraps it keeps it in its chain, even when the underlying error is itself a problem.
+func (e *RolloutError) GetConsoleMessage() string {
+	return e.Error()
+}
+
+// GetDebugMessage implements the core.Problem interface.
+func (e *RolloutError) GetDebugMessage() string {
+	var problem core.Problem
+	if errors.As(e.Err, &problem) {
+		return problem.GetDebugMessage()
+	}
+	return e.Error()
+}
+
+// GetID implements the core.Problem interface.
+func (e *RolloutError) GetID() string {
+	return core.CreateIDHash("rollout", e.Stage, e.StateCode)
+}
+
+// isBlockingStateCode returns true for the state codes that keep a configuration from being validated.
+func isBlockingStateCode(stateCode string) bool {
+	return stateCode == ProjectConfig_StateCode_AwaitingInput || stateCode == ProjectConfig_StateCode_AwaitingPrerequisite
+}
+
+// RolloutConfig : Validate, approve and deploy a configuration
+// Run the validation of the configuration draft and wait for it to finish, approve the draft (or force approve it when
+// the Force option is set), then deploy the configuration and wait for the deployment to finish. A configuration with
+// the `awaiting_input` or `awaiting_prerequisite` state code, before or after the validation, is not approved; the
+// rollout stops with a RolloutError that reports the state code. If a compliance gate is set, the Code Risk Analyzer
+// results of the validation are checked before the approval; the rollout stops with a RolloutError that wraps a
+// *ComplianceGateError if they do not meet it. The returned error wraps the RolloutError, which errors.As finds. The
+// returned report describes every stage that completed, even when an error is returned.
+func (project *ProjectV1) RolloutConfig(rolloutConfigOptions *RolloutConfigOptions) (result *RolloutReport, err error) {
+	result, err = project.RolloutConfigWithContext(context.Background(), rolloutConfigOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// RolloutConfigWithContext is an alternate form of the RolloutConfig method which supports a Context parameter
+func (project *ProjectV1) RolloutConfigWithContext(ctx context.Context, rolloutConfigOptions *RolloutConfigOptions) (result *RolloutReport, err error) {
+	return project.rolloutConfig(ctx, project, rolloutConfigOptions)
+}
+
+// rolloutConfig runs a rollout and sends the approval and the deployment through the methods of actions.
+func (project *ProjectV1) rolloutConfig(ctx context.Context, actions configActions, rolloutConfigOptions *RolloutConfigOptions) (result *RolloutReport, err error) {
+	err = core.ValidateNotNil(rolloutConfigOptions, "rolloutConfigOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(rolloutConfigOptions, "rolloutConfigOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	force := rolloutConfigOptions.Force != nil && *rolloutConfigOptions.Force
+	if force && core.StringNilMapper(rolloutConfigOptions.Comment) == "" {
+		err = core.SDKErrorf(nil, "a comment is required to force approve a configuration", "missing-comment", common.GetComponentInfo())
+		return
+	}
+
+	projectID := rolloutConfigOptions.ProjectID
+	configID := rolloutConfigOptions.ID
+	headers := rolloutConfigOptions.Headers
+	result = &RolloutReport{
+		ProjectID: *projectID,
+		ConfigID:  *configID,
+		Forced:    force,
+	}
+	newWaitOptions := func(timeout time.Duration, targetStates ...string) *WaitForConfigStateOptions {
+		return &WaitForConfigStateOptions{
+			ProjectID:       projectID,
+			ID:              configID,
+			TargetStates:    targetStates,
+			PollInterval:    rolloutConfigOptions.PollInterval,
+			MaxPollInterval: rolloutConfigOptions.MaxPollInterval,
+			BackoffFactor:   rolloutConfigOptions.BackoffFactor,
+			Timeout:         timeout,
+			Headers:         headers,
+		}
+	}
+
+	// Validate.
+	config, _, err := project.GetConfigWithContext(ctx, &GetConfigOptions{ProjectID: projectID, ID: configID, Headers: headers})
+	if err != nil {
+		err = newRolloutError(RolloutError_Stage_Validate, nil, err)
+		return
+	}
+	result.Config = config
+	if isBlockingStateCode(core.StringNilMapper(config.StateCode)) {
+		err = newRolloutError(RolloutError_Stage_Validate, config,
+			core.SDKErrorf(nil, fmt.Sprintf("configuration '%s' cannot be validated", *configID), "config-blocked", common.GetComponentInfo()))
+		return
+	}
+	_, _, err = project.ValidateConfigWithContext(ctx, &ValidateConfigOptions{ProjectID: projectID, ID: configID, Headers: headers})
+	if err != nil {
+		err = newRolloutError(RolloutError_Stage_Validate, config, err)
+		return
+	}
+	validatedStates := []string{ProjectConfig_State_Validated}
+	if force {
+		validatedStates = append(validatedStates, ProjectConfig_State_ValidatingFailed)
+	}
+	config, _, err = project.WaitForConfigStateWithContext(ctx, newWaitOptions(rolloutConfigOptions.ValidateTimeout, validatedStates...))
+	if err != nil {
+		err = newRolloutError(RolloutError_Stage_Validate, lastWaitedConfig(err), err)
+		return
+	}
+	result.Config = config
+	result.LastValidated = config.LastValidated
+	if config.LastValidated != nil && config.LastValidated.Job != nil {
+		result.ValidationSummary = config.LastValidated.Job.Summary
+	}
+
+	// Approve.
+	if isBlockingStateCode(core.StringNilMapper(config.StateCode)) {
+		err = newRolloutError(RolloutError_Stage_Approve, config,
+			core.SDKErrorf(nil, fmt.Sprintf("configuration '%s' cannot be approved", *configID), "config-blocked", common.GetComponentInfo()))
+		return
+	}
+	if rolloutConfigOptions.ComplianceGate != nil {
+		result.ComplianceVerdict, err = evaluateComplianceGate(rolloutConfigOptions.ComplianceGate, config)
+		if err != nil {
+			err = newRolloutError(RolloutError_Stage_Approve, config, err)
+			return
+		}
+	}
+	var approved *ProjectConfigVersion
+	if force {
+		approved, _, err = actions.ForceApproveWithContext(ctx, &ForceApproveOptions{
+			ProjectID: projectID,
+			ID:        configID,
+			Comment:   rolloutConfigOptions.Comment,
+			Headers:   headers,
+		})
+	} else {
+		approved, _, err = actions.ApproveWithContext(ctx, &ApproveOptions{
+			ProjectID: projectID,
+			ID:        configID,
+			Comment:   rolloutConfigOptions.Comment,
+			Headers:   headers,
+		})
+	}
+	if err != nil {
+		err = newRolloutError(RolloutError_Stage_Approve, config, err)
+		return
+	}
+	result.Version = approved.Version
+
+	// Deploy.
+	_, _, err = actions.DeployConfigWithContext(ctx, &DeployConfigOptions{ProjectID: projectID, ID: configID, Headers: headers})
+	if err != nil {
+		err = newRolloutError(RolloutError_Stage_Deploy, config, err)
+		return
+	}
+	config, _, err = project.WaitForConfigStateWithContext(ctx, newWaitOptions(rolloutConfigOptions.DeployTimeout, ProjectConfig_State_Deployed))
+	if last := lastWaitedConfig(err); last != nil {
+		config = last
+	}
+	if config != nil {
+		result.Config = config
+		result.LastDeployed = config.LastDeployed
+		if config.LastDeployed != nil && config.LastDeployed.Job != nil {
+			result.DeploymentSummary = config.LastDeployed.Job.Summary
+		}
+	}
+	if err != nil {
+		err = newRolloutError(RolloutError_Stage_Deploy, config, err)
+		return
+	}
+
+	return
+}
+
+// newRolloutError returns an SDK problem that wraps a RolloutError for a stage.
+func newRolloutError(stage string, config *ProjectConfig, err error) error {
+	rolloutErr := &RolloutError{
+		Stage: stage,
+		Err:   err,
+	}
+	if config != nil {
+		rolloutErr.StateCode = core.StringNilMapper(config.StateCode)
+	}
+	return core.SDKErrorf(rolloutErr, "", "rollout-stopped", common.GetComponentInfo())
+}
+
+// lastWaitedConfig returns the last configuration that is held by a ConfigStateError in the chain of err, if any.
+func lastWaitedConfig(err error) *ProjectConfig {
+	var stateErr *ConfigStateError
+	if errors.As(err, &stateErr) {
+		return stateErr.Config
+	}
+	return nil
+}
@@ -0,0 +1,260 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/project-go-sdk/projectv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`RolloutConfig`, func() {
+	var testServer *httptest.Server
+	var projectService *projectv1.ProjectV1
+
+	configPath := "/v1/projects/testString/configs/testString"
+	jobJSON := func(id string) string {
+		return fmt.Sprintf(`{"id": "%s", "summary": {"version": "1", "plan_summary": {"add": 2}}}`, id)
+	}
+
+	// rolloutServer simulates a configuration moving through validation and deployment. Each action moves the
+	// configuration to the matching "-ing" state; the next GetConfig completes it with the given outcome.
+	type rolloutServer struct {
+		sync.Mutex
+		state           string
+		stateCode       string
+		validateOutcome string
+		validateCode    string
+		deployOutcome   string
+		calls           []string
+		approveComment  interface{}
+	}
+	var server *rolloutServer
+
+	startServer := func(s *rolloutServer) {
+		server = s
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			server.Lock()
+			defer server.Unlock()
+
+			action := req.Method + " " + req.URL.EscapedPath()
+			server.calls = append(server.calls, action)
+			switch action {
+			case "GET " + configPath:
+				switch server.state {
+				case projectv1.ProjectConfig_State_Validating:
+					server.state = server.validateOutcome
+					server.stateCode = server.validateCode
+				case projectv1.ProjectConfig_State_Deploying:
+					server.state = server.deployOutcome
+				}
+			case "POST " + configPath + "/validate":
+				server.state = projectv1.ProjectConfig_State_Validating
+			case "POST " + configPath + "/approve", "POST " + configPath + "/force_approve":
+				var body map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				server.approveComment = body["comment"]
+				server.state = projectv1.ProjectConfig_State_Approved
+			case "POST " + configPath + "/deploy":
+				server.state = projectv1.ProjectConfig_State_Deploying
+			default:
+				Fail("unexpected request: " + action)
+			}
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprintf(res, `{"id": "testString", "version": 3, "state": "%s", "state_code": "%s", "last_validated": {"href": "h", "result": "passed", "job": %s}, "last_deployed": {"href": "h", "result": "passed", "job": %s}}`,
+				server.state, server.stateCode, jobJSON("validate-job"), jobJSON("deploy-job"))
+		}))
+		var serviceErr error
+		projectService, serviceErr = projectv1.NewProjectV1(&projectv1.ProjectV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	}
+
+	newOptions := func() *projectv1.RolloutConfigOptions {
+		return projectService.NewRolloutConfigOptions("testString", "testString").
+			SetPollInterval(time.Millisecond).
+			SetMaxPollInterval(time.Millisecond)
+	}
+
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Validates, approves and deploys the configuration`, func() {
+		startServer(&rolloutServer{
+			state:           projectv1.ProjectConfig_State_Draft,
+			validateOutcome: projectv1.ProjectConfig_State_Validated,
+			deployOutcome:   projectv1.ProjectConfig_State_Deployed,
+		})
+
+		report, err := projectService.RolloutConfig(newOptions().SetComment("ship it"))
+		Expect(err).To(BeNil())
+		Expect(report.ProjectID).To(Equal("testString"))
+		Expect(report.ConfigID).To(Equal("testString"))
+		Expect(*report.Version).To(Equal(int64(3)))
+		Expect(report.Forced).To(BeFalse())
+		Expect(*report.LastValidated.Job.ID).To(Equal("validate-job"))
+		Expect(*report.ValidationSummary.PlanSummary.Add).To(Equal(int64(2)))
+		Expect(*report.LastDeployed.Job.ID).To(Equal("deploy-job"))
+		Expect(report.DeploymentSummary).ToNot(BeNil())
+		Expect(*report.Config.State).To(Equal(projectv1.ProjectConfig_State_Deployed))
+		Expect(server.approveComment).To(Equal("ship it"))
+		Expect(server.calls).To(Equal([]string{
+			"GET " + configPath,
+			"POST " + configPath + "/validate",
+			"GET " + configPath,
+			"POST " + configPath + "/approve",
+			"POST " + configPath + "/deploy",
+			"GET " + configPath,
+		}))
+	})
+	It(`Force approves a configuration that failed validation`, func() {
+		startServer(&rolloutServer{
+			state:           projectv1.ProjectConfig_State_Draft,
+			validateOutcome: projectv1.ProjectConfig_State_ValidatingFailed,
+			deployOutcome:   projectv1.ProjectConfig_State_Deployed,
+		})
+
+		report, err := projectService.RolloutConfig(newOptions().SetForce(true).SetComment("known issue"))
+		Expect(err).To(BeNil())
+		Expect(report.Forced).To(BeTrue())
+		Expect(server.calls).To(ContainElement("POST " + configPath + "/force_approve"))
+		Expect(server.approveComment).To(Equal("known issue"))
+	})
+	It(`Stops when validation fails`, func() {
+		startServer(&rolloutServer{
+			state:           projectv1.ProjectConfig_State_Draft,
+			validateOutcome: projectv1.ProjectConfig_State_ValidatingFailed,
+		})
+
+		report, err := projectService.RolloutConfig(newOptions())
+		Expect(err).ToNot(BeNil())
+		Expect(report).ToNot(BeNil())
+		Expect(report.Version).To(BeNil())
+
+		var rolloutErr *projectv1.RolloutError
+		Expect(errors.As(err, &rolloutErr)).To(BeTrue())
+		Expect(rolloutErr.Stage).To(Equal(projectv1.RolloutError_Stage_Validate))
+		var stateErr *projectv1.ConfigStateError
+		Expect(errors.As(err, &stateErr)).To(BeTrue())
+		Expect(stateErr.State()).To(Equal(projectv1.ProjectConfig_State_ValidatingFailed))
+	})
+	It(`Reports the deployment when it fails`, func() {
+		startServer(&rolloutServer{
+			state:           projectv1.ProjectConfig_State_Draft,
+			validateOutcome: projectv1.ProjectConfig_State_Validated,
+			deployOutcome:   projectv1.ProjectConfig_State_DeployingFailed,
+		})
+
+		report, err := projectService.RolloutConfig(newOptions())
+		var rolloutErr *projectv1.RolloutError
+		Expect(errors.As(err, &rolloutErr)).To(BeTrue())
+		Expect(rolloutErr.Stage).To(Equal(projectv1.RolloutError_Stage_Deploy))
+		Expect(*report.Version).To(Equal(int64(3)))
+		Expect(*report.LastDeployed.Job.ID).To(Equal("deploy-job"))
+		Expect(*report.Config.State).To(Equal(projectv1.ProjectConfig_State_DeployingFailed))
+	})
+	It(`Does not validate a configuration that is awaiting input`, func() {
+		startServer(&rolloutServer{
+			state:     projectv1.ProjectConfig_State_Draft,
+			stateCode: projectv1.ProjectConfig_StateCode_AwaitingInput,
+		})
+
+		_, err := projectService.RolloutConfig(newOptions())
+		var rolloutErr *projectv1.RolloutError
+		Expect(errors.As(err, &rolloutErr)).To(BeTrue())
+		Expect(rolloutErr.Stage).To(Equal(projectv1.RolloutError_Stage_Validate))
+		Expect(rolloutErr.StateCode).To(Equal(projectv1.ProjectConfig_StateCode_AwaitingInput))
+		Expect(rolloutErr.Blocked()).To(BeTrue())
+		Expect(server.calls).To(Equal([]string{"GET " + configPath}))
+	})
+	It(`Does not approve a configuration that is awaiting a prerequisite after the validation`, func() {
+		startServer(&rolloutServer{
+			state:           projectv1.ProjectConfig_State_Draft,
+			validateOutcome: projectv1.ProjectConfig_State_Validated,
+			validateCode:    projectv1.ProjectConfig_StateCode_AwaitingPrerequisite,
+		})
+
+		report, err := projectService.RolloutConfig(newOptions())
+		var rolloutErr *projectv1.RolloutError
+		Expect(errors.As(err, &rolloutErr)).To(BeTrue())
+		Expect(rolloutErr.Stage).To(Equal(projectv1.RolloutError_Stage_Approve))
+		Expect(rolloutErr.StateCode).To(Equal(projectv1.ProjectConfig_StateCode_AwaitingPrerequisite))
+		var sdkProblem *core.SDKProblem
+		Expect(errors.As(err, &sdkProblem)).To(BeTrue())
+		Expect(rolloutErr.Blocked()).To(BeTrue())
+		Expect(report.LastValidated).ToNot(BeNil())
+		Expect(server.calls).ToNot(ContainElement("POST " + configPath + "/approve"))
+	})
+	It(`Stops before the approval when the compliance gate blocks the configuration`, func() {
+		startServer(&rolloutServer{
+			state:           projectv1.ProjectConfig_State_Draft,
+			validateOutcome: projectv1.ProjectConfig_State_Validated,
+		})
+
+		report, err := projectService.RolloutConfig(newOptions().SetComplianceGate(projectv1.NewComplianceGate()))
+		var rolloutErr *projectv1.RolloutError
+		Expect(errors.As(err, &rolloutErr)).To(BeTrue())
+		Expect(rolloutErr.Stage).To(Equal(projectv1.RolloutError_Stage_Approve))
+		var gateErr *projectv1.ComplianceGateError
+		Expect(errors.As(err, &gateErr)).To(BeTrue())
+		Expect(report.ComplianceVerdict.Allowed).To(BeFalse())
+		Expect(server.calls).ToNot(ContainElement("POST " + configPath + "/approve"))
+	})
+	It(`Invoke RolloutConfig with error: Param validation error`, func() {
+		startServer(&rolloutServer{})
+
+		_, err := projectService.RolloutConfig(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = projectService.RolloutConfig(new(projectv1.RolloutConfigOptions))
+		Expect(err).ToNot(BeNil())
+		_, err = projectService.RolloutConfig(newOptions().SetForce(true))
+		Expect(err).ToNot(BeNil())
+		Expect(server.calls).To(BeEmpty())
+	})
+	It(`Invoke NewRolloutConfigOptions successfully`, func() {
+		startServer(&rolloutServer{})
+
+		options := projectService.NewRolloutConfigOptions("testString", "testString")
+		options.SetProjectID("projectID").SetID("configID").SetComment("comment").SetForce(true).
+			SetPollInterval(time.Second).SetMaxPollInterval(time.Minute).SetBackoffFactor(2).
+			SetValidateTimeout(time.Hour).SetDeployTimeout(2 * time.Hour).SetHeaders(map[string]string{"foo": "bar"})
+		Expect(options.ProjectID).To(Equal(core.StringPtr("projectID")))
+		Expect(options.ID).To(Equal(core.StringPtr("configID")))
+		Expect(options.Comment).To(Equal(core.StringPtr("comment")))
+		Expect(options.Force).To(Equal(core.BoolPtr(true)))
+		Expect(options.PollInterval).To(Equal(time.Second))
+		Expect(options.MaxPollInterval).To(Equal(time.Minute))
+		Expect(options.BackoffFactor).To(Equal(2.0))
+		Expect(options.ValidateTimeout).To(Equal(time.Hour))
+		Expect(options.DeployTimeout).To(Equal(2 * time.Hour))
+		Expect(options.Headers).To(Equal(map[string]string{"foo": "bar"}))
+	})
+})
@@ -0,0 +1,327 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/project-go-sdk/common"
+)
+
+// Defaults used by WaitForConfigState when the corresponding option is not set.
+const (
+	DefaultWaitPollInterval    = 10 * time.Second
+	DefaultWaitMaxPollInterval = 2 * time.Minute
+	DefaultWaitBackoffFactor   = 1.5
+)
+
+// DefaultConfigFailureStates are the configuration states that end a validate, deploy, undeploy or delete
+// action unsuccessfully. WaitForConfigState stops waiting as soon as one of them is reached.
+var DefaultConfigFailureStates = []string{
+	ProjectConfig_State_ApplyFailed,
+	ProjectConfig_State_DeletingFailed,
+	ProjectConfig_State_DeployingFailed,
+	ProjectConfig_State_UndeployingFailed,
+	ProjectConfig_State_ValidatingFailed,
+}
+
+// WaitForConfigStateOptions : The WaitForConfigState options.
+type WaitForConfigStateOptions struct {
+	// The unique project ID.
+	ProjectID *string `json:"project_id" validate:"required,ne="`
+
+	// The unique configuration ID.
+	ID *string `json:"id" validate:"required,ne="`
+
+	// The configuration states to wait for. The wait ends successfully as soon as the configuration reaches one of
+	// them.
+	TargetStates []string `json:"target_states" validate:"required,min=1"`
+
+	// The configuration states that end the wait with a ConfigStateError. When not set,
+	// DefaultConfigFailureStates is used. A state that is also listed in TargetStates is treated as a target.
+	FailureStates []string `json:"failure_states,omitempty"`
+
+	// The delay between the first two polls. Defaults to DefaultWaitPollInterval.
+	PollInterval time.Duration `json:"poll_interval,omitempty"`
+
+	// The upper bound for the delay between polls. Defaults to DefaultWaitMaxPollInterval.
+	MaxPollInterval time.Duration `json:"max_poll_interval,omitempty"`
+
+	// The factor by which the delay grows after each poll. Values lower than 1 are replaced by
+	// DefaultWaitBackoffFactor; use 1 for a fixed poll interval.
+	BackoffFactor float64 `json:"backoff_factor,omitempty"`
+
+	// The maximum time to wait. A zero value waits until the context is done.
+	Timeout time.Duration `json:"timeout,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewWaitForConfigStateOptions : Instantiate WaitForConfigStateOptions
+func (*ProjectV1) NewWaitForConfigStateOptions(projectID string, id string, targetStates []string) *WaitForConfigStateOptions {
+	return &WaitForConfigStateOptions{
+		ProjectID:    core.StringPtr(projectID),
+		ID:           core.StringPtr(id),
+		TargetStates: targetStates,
+	}
+}
+
+// SetProjectID : Allow user to set ProjectID
+func (_options *WaitForConfigStateOptions) SetProjectID(projectID string) *WaitForConfigStateOptions {
+	_options.ProjectID = core.StringPtr(projectID)
+	return _options
+}
+
+// SetID : Allow user to set ID
+func (_options *WaitForConfigStateOptions) SetID(id string) *WaitForConfigStateOptions {
+	_options.ID = core.StringPtr(id)
+	return _options
+}
+
+// SetTargetStates : Allow user to set TargetStates
+func (_options *WaitForConfigStateOptions) SetTargetStates(targetStates []string) *WaitForConfigStateOptions {
+	_options.TargetStates = targetStates
+	return _options
+}
+
+// SetFailureStates : Allow user to set FailureStates
+func (_options *WaitForConfigStateOptions) SetFailureStates(failureStates []string) *WaitForConfigStateOptions {
+	_options.FailureStates = failureStates
+	return _options
+}
+
+// SetPollInterval : Allow user to set PollInterval
+func (_options *WaitForConfigStateOptions) SetPollInterval(pollInterval time.Duration) *WaitForConfigStateOptions {
+	_options.PollInterval = pollInterval
+	return _options
+}
+
+// SetMaxPollInterval : Allow user to set MaxPollInterval
+func (_options *WaitForConfigStateOptions) SetMaxPollInterval(maxPollInterval time.Duration) *WaitForConfigStateOptions {
+	_options.MaxPollInterval = maxPollInterval
+	return _options
+}
+
+// SetBackoffFactor : Allow user to set BackoffFactor
+func (_options *WaitForConfigStateOptions) SetBackoffFactor(backoffFactor float64) *WaitForConfigStateOptions {
+	_options.BackoffFactor = backoffFactor
+	return _options
+}
+
+// SetTimeout : Allow user to set Timeout
+func (_options *WaitForConfigStateOptions) SetTimeout(timeout time.Duration) *WaitForConfigStateOptions {
+	_options.Timeout = timeout
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *WaitForConfigStateOptions) SetHeaders(param map[string]string) *WaitForConfigStateOptions {
+	options.Headers = param
+	return options
+}
+
+// ConfigStateError : The error returned by WaitForConfigState when the configuration reaches a failure state, or when
+// the wait ends before the configuration reaches one of the target states.
+type ConfigStateError struct {
+	// The last configuration that was retrieved, or nil if no poll completed.
+	Config *ProjectConfig
+
+	// The error from config actions that is reported by Config, if any.
+	ConfigError *ProjectConfigError
+
+	// The states that were waited for.
+	TargetStates []string
+
+	// The reason the wait ended early, such as context.DeadlineExceeded. Nil when a failure state was reached.
+	Err error
+}
+
+// State returns the last observed state of the configuration, or an empty string if it is unknown.
+func (e *ConfigStateError) State() string {
+	if e.Config == nil || e.Config.State == nil {
+		return ""
+	}
+	return *e.Config.State
+}
+
+// Failed returns true if the wait ended because the configuration reached a failure state.
+func (e *ConfigStateError) Failed() bool {
+	return e.Err == nil
+}
+
+// Error implements the error interface.
+func (e *ConfigStateError) Error() string {
+	var msg string
+	if e.Failed() {
+		msg = fmt.Sprintf("configuration reached state '%s' while waiting for %s", e.State(), strings.Join(e.TargetStates, ", "))
+	} else {
+		msg = fmt.Sprintf("stopped waiting for %s in state '%s': %s", strings.Join(e.TargetStates, ", "), e.State(), e.Err.Error())
+	}
+	if e.ConfigError != nil && e.ConfigError.Message != nil {
+		msg += ": " + *e.ConfigError.Message
+	}
+	return msg
+}
+
+// Unwrap returns the reason the wait ended early, if any.
+func (e *ConfigStateError) Unwrap() error {
+	return e.Err
+}
+
+// WaitForConfigState : Wait for a configuration to reach a state
+// Poll the configuration with GetConfig until its state is one of the target states. The first poll is sent right away,
+// so a configuration that is already in a target state is returned at once. The delay between polls starts at the poll
+// interval and grows by the backoff factor up to the maximum poll interval. If the configuration reaches a
+// failure state, or the timeout expires first, a ConfigStateError holding the last configuration is returned.
+func (project *ProjectV1) WaitForConfigState(waitForConfigStateOptions *WaitForConfigStateOptions) (result *ProjectConfig, response *core.DetailedResponse, err error) {
+	result, response, err = project.WaitForConfigStateWithContext(context.Background(), waitForConfigStateOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// WaitForConfigStateWithContext is an alternate form of the WaitForConfigState method which supports a Context parameter
+func (project *ProjectV1) WaitForConfigStateWithContext(ctx context.Context, waitForConfigStateOptions *WaitForConfigStateOptions) (result *ProjectConfig, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(waitForConfigStateOptions, "waitForConfigStateOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(waitForConfigStateOptions, "waitForConfigStateOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	if waitForConfigStateOptions.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, waitForConfigStateOptions.Timeout)
+		defer cancel()
+	}
+
+	failureStates := waitForConfigStateOptions.FailureStates
+	if failureStates == nil {
+		failureStates = DefaultConfigFailureStates
+	}
+	backoff := newPollBackoff(waitForConfigStateOptions.PollInterval, waitForConfigStateOptions.MaxPollInterval, waitForConfigStateOptions.BackoffFactor)
+
+	getConfigOptions := &GetConfigOptions{
+		ProjectID: waitForConfigStateOptions.ProjectID,
+		ID:        waitForConfigStateOptions.ID,
+		Headers:   waitForConfigStateOptions.Headers,
+	}
+	var last *ProjectConfig
+	for {
+		var config *ProjectConfig
+		config, response, err = project.GetConfigWithContext(ctx, getConfigOptions)
+		if err != nil {
+			if ctx.Err() != nil {
+				err = core.SDKErrorf(newConfigStateError(last, waitForConfigStateOptions.TargetStates, ctx.Err()), "", "wait-interrupted", common.GetComponentInfo())
+				return
+			}
+			err = core.RepurposeSDKProblem(err, "get-config-error")
+			return
+		}
+		last = config
+
+		state := core.StringNilMapper(config.State)
+		if containsString(waitForConfigStateOptions.TargetStates, state) {
+			result = config
+			return
+		}
+		if containsString(failureStates, state) {
+			err = core.SDKErrorf(newConfigStateError(last, waitForConfigStateOptions.TargetStates, nil), "", "config-state-failed", common.GetComponentInfo())
+			return
+		}
+
+		err = backoff.wait(ctx)
+		if err != nil {
+			err = core.SDKErrorf(newConfigStateError(last, waitForConfigStateOptions.TargetStates, err), "", "wait-interrupted", common.GetComponentInfo())
+			return
+		}
+	}
+}
+
+func newConfigStateError(config *ProjectConfig, targetStates []string, cause error) *ConfigStateError {
+	stateErr := &ConfigStateError{
+		Config:       config,
+		TargetStates: targetStates,
+		Err:          cause,
+	}
+	if config != nil {
+		stateErr.ConfigError = config.ConfigError
+	}
+	return stateErr
+}
+
+// pollBackoff computes the delays between successive polls of a long-running operation.
+type pollBackoff struct {
+	next   time.Duration
+	max    time.Duration
+	factor float64
+}
+
+func newPollBackoff(interval time.Duration, max time.Duration, factor float64) *pollBackoff {
+	if interval <= 0 {
+		interval = DefaultWaitPollInterval
+	}
+	if max <= 0 {
+		max = DefaultWaitMaxPollInterval
+	}
+	if max < interval {
+		max = interval
+	}
+	if factor < 1 {
+		factor = DefaultWaitBackoffFactor
+	}
+	return &pollBackoff{
+		next:   interval,
+		max:    max,
+		factor: factor,
+	}
+}
+
+// wait sleeps for the current delay, then grows it. It returns the context error if the context is done first.
+func (b *pollBackoff) wait(ctx context.Context) error {
+	timer := time.NewTimer(b.next)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+	}
+
+	b.next = time.Duration(float64(b.next) * b.factor)
+	if b.next > b.max {
+		b.next = b.max
+	}
+	return nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
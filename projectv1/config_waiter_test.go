@@ -0,0 +1,176 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/project-go-sdk/projectv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`WaitForConfigState`, func() {
+	var testServer *httptest.Server
+	var projectService *projectv1.ProjectV1
+	var polls int32
+
+	getConfigPath := "/v1/projects/testString/configs/testString"
+
+	// serveStates responds to successive GetConfig calls with the given states, repeating the last one.
+	serveStates := func(states ...string) {
+		atomic.StoreInt32(&polls, 0)
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.URL.EscapedPath()).To(Equal(getConfigPath))
+			Expect(req.Method).To(Equal("GET"))
+			n := int(atomic.AddInt32(&polls, 1))
+			if n > len(states) {
+				n = len(states)
+			}
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprintf(res, `{"id": "testString", "version": 1, "state": "%s", "config_error": {"message": "job failed"}}`, states[n-1])
+		}))
+		var serviceErr error
+		projectService, serviceErr = projectv1.NewProjectV1(&projectv1.ProjectV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	}
+
+	newOptions := func(targetStates ...string) *projectv1.WaitForConfigStateOptions {
+		return projectService.NewWaitForConfigStateOptions("testString", "testString", targetStates).
+			SetPollInterval(time.Millisecond).
+			SetMaxPollInterval(5 * time.Millisecond)
+	}
+
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Returns the configuration once a target state is reached`, func() {
+		serveStates(projectv1.ProjectConfig_State_Validating, projectv1.ProjectConfig_State_Validating, projectv1.ProjectConfig_State_Validated)
+
+		result, response, err := projectService.WaitForConfigState(newOptions(projectv1.ProjectConfig_State_Validated))
+		Expect(err).To(BeNil())
+		Expect(response).ToNot(BeNil())
+		Expect(result).ToNot(BeNil())
+		Expect(*result.State).To(Equal(projectv1.ProjectConfig_State_Validated))
+		Expect(atomic.LoadInt32(&polls)).To(Equal(int32(3)))
+	})
+	It(`Returns at once when the configuration is already in a target state`, func() {
+		serveStates(projectv1.ProjectConfig_State_Validated)
+
+		start := time.Now()
+		result, _, err := projectService.WaitForConfigState(projectService.NewWaitForConfigStateOptions("testString", "testString",
+			[]string{projectv1.ProjectConfig_State_Validated}))
+		Expect(err).To(BeNil())
+		Expect(*result.State).To(Equal(projectv1.ProjectConfig_State_Validated))
+		Expect(atomic.LoadInt32(&polls)).To(Equal(int32(1)))
+		Expect(time.Since(start)).To(BeNumerically("<", projectv1.DefaultWaitPollInterval))
+	})
+	It(`Fails fast on a failure state`, func() {
+		serveStates(projectv1.ProjectConfig_State_Deploying, projectv1.ProjectConfig_State_DeployingFailed, projectv1.ProjectConfig_State_Deployed)
+
+		result, _, err := projectService.WaitForConfigState(newOptions(projectv1.ProjectConfig_State_Deployed))
+		Expect(err).ToNot(BeNil())
+		Expect(result).To(BeNil())
+		Expect(atomic.LoadInt32(&polls)).To(Equal(int32(2)))
+
+		var stateErr *projectv1.ConfigStateError
+		Expect(errors.As(err, &stateErr)).To(BeTrue())
+		Expect(stateErr.Failed()).To(BeTrue())
+		Expect(stateErr.State()).To(Equal(projectv1.ProjectConfig_State_DeployingFailed))
+		Expect(*stateErr.ConfigError.Message).To(Equal("job failed"))
+		Expect(stateErr.Config).ToNot(BeNil())
+	})
+	It(`Treats a failure state listed as a target as a target`, func() {
+		serveStates(projectv1.ProjectConfig_State_ValidatingFailed)
+
+		result, _, err := projectService.WaitForConfigState(newOptions(projectv1.ProjectConfig_State_Validated, projectv1.ProjectConfig_State_ValidatingFailed))
+		Expect(err).To(BeNil())
+		Expect(*result.State).To(Equal(projectv1.ProjectConfig_State_ValidatingFailed))
+	})
+	It(`Honors custom failure states`, func() {
+		serveStates(projectv1.ProjectConfig_State_Draft)
+
+		_, _, err := projectService.WaitForConfigState(newOptions(projectv1.ProjectConfig_State_Validated).
+			SetFailureStates([]string{projectv1.ProjectConfig_State_Draft}))
+		var stateErr *projectv1.ConfigStateError
+		Expect(errors.As(err, &stateErr)).To(BeTrue())
+		Expect(stateErr.State()).To(Equal(projectv1.ProjectConfig_State_Draft))
+	})
+	It(`Returns the last configuration when the timeout expires`, func() {
+		serveStates(projectv1.ProjectConfig_State_Undeploying)
+
+		_, _, err := projectService.WaitForConfigState(newOptions(projectv1.ProjectConfig_State_Approved).
+			SetTimeout(50 * time.Millisecond))
+		Expect(err).ToNot(BeNil())
+		Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+
+		var stateErr *projectv1.ConfigStateError
+		Expect(errors.As(err, &stateErr)).To(BeTrue())
+		Expect(stateErr.Failed()).To(BeFalse())
+		Expect(stateErr.State()).To(Equal(projectv1.ProjectConfig_State_Undeploying))
+	})
+	It(`Stops when the context is canceled`, func() {
+		serveStates(projectv1.ProjectConfig_State_Validating)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, _, err := projectService.WaitForConfigStateWithContext(ctx, newOptions(projectv1.ProjectConfig_State_Validated))
+		Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+		Expect(atomic.LoadInt32(&polls)).To(Equal(int32(0)))
+	})
+	It(`Invoke WaitForConfigState with error: Param validation error`, func() {
+		serveStates(projectv1.ProjectConfig_State_Validated)
+
+		_, _, err := projectService.WaitForConfigState(nil)
+		Expect(err).ToNot(BeNil())
+		_, _, err = projectService.WaitForConfigState(new(projectv1.WaitForConfigStateOptions))
+		Expect(err).ToNot(BeNil())
+		_, _, err = projectService.WaitForConfigState(newOptions())
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Invoke NewWaitForConfigStateOptions successfully`, func() {
+		serveStates(projectv1.ProjectConfig_State_Validated)
+
+		options := projectService.NewWaitForConfigStateOptions("testString", "testString", []string{"validated"})
+		options.SetProjectID("projectID").SetID("configID").SetTargetStates([]string{"deployed"}).
+			SetFailureStates([]string{"deploying_failed"}).SetPollInterval(time.Second).SetMaxPollInterval(time.Minute).
+			SetBackoffFactor(2).SetTimeout(time.Hour).SetHeaders(map[string]string{"foo": "bar"})
+		Expect(options.ProjectID).To(Equal(core.StringPtr("projectID")))
+		Expect(options.ID).To(Equal(core.StringPtr("configID")))
+		Expect(options.TargetStates).To(Equal([]string{"deployed"}))
+		Expect(options.FailureStates).To(Equal([]string{"deploying_failed"}))
+		Expect(options.PollInterval).To(Equal(time.Second))
+		Expect(options.MaxPollInterval).To(Equal(time.Minute))
+		Expect(options.BackoffFactor).To(Equal(2.0))
+		Expect(options.Timeout).To(Equal(time.Hour))
+		Expect(options.Headers).To(Equal(map[string]string{"foo": "bar"}))
+	})
+})
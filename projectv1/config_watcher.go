@@ -0,0 +1,397 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/project-go-sdk/common"
+	"github.com/go-openapi/strfmt"
+)
+
+// DefaultWatchPollInterval is the delay between the snapshots of Watch when WatchOptions.PollInterval is not set.
+const DefaultWatchPollInterval = 30 * time.Second
+
+// Constants associated with the WatchEvent.Type property.
+// What changed in the project.
+const (
+	WatchEvent_Type_ConfigCreated  = "config_created"
+	WatchEvent_Type_ConfigDeleted  = "config_deleted"
+	WatchEvent_Type_DeployFailed   = "deploy_failed"
+	WatchEvent_Type_DeployFinished = "deploy_finished"
+	WatchEvent_Type_DriftDetected  = "drift_detected"
+	WatchEvent_Type_Error          = "error"
+	WatchEvent_Type_NeedsAttention = "needs_attention"
+	WatchEvent_Type_StateChanged   = "state_changed"
+	WatchEvent_Type_VersionCreated = "version_created"
+)
+
+// WatchOptions : The Watch options.
+type WatchOptions struct {
+	// The unique project ID.
+	ProjectID *string `json:"project_id" validate:"required,ne="`
+
+	// The delay between two snapshots of the configurations. Defaults to DefaultWatchPollInterval.
+	PollInterval time.Duration `json:"poll_interval,omitempty"`
+
+	// The maximum time to watch. A zero value watches until the context is done.
+	Timeout time.Duration `json:"timeout,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewWatchOptions : Instantiate WatchOptions
+func (*ProjectV1) NewWatchOptions(projectID string) *WatchOptions {
+	return &WatchOptions{
+		ProjectID: core.StringPtr(projectID),
+	}
+}
+
+// SetProjectID : Allow user to set ProjectID
+func (_options *WatchOptions) SetProjectID(projectID string) *WatchOptions {
+	_options.ProjectID = core.StringPtr(projectID)
+	return _options
+}
+
+// SetPollInterval : Allow user to set PollInterval
+func (_options *WatchOptions) SetPollInterval(pollInterval time.Duration) *WatchOptions {
+	_options.PollInterval = pollInterval
+	return _options
+}
+
+// SetTimeout : Allow user to set Timeout
+func (_options *WatchOptions) SetTimeout(timeout time.Duration) *WatchOptions {
+	_options.Timeout = timeout
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *WatchOptions) SetHeaders(param map[string]string) *WatchOptions {
+	options.Headers = param
+	return options
+}
+
+// WatchEvent : A change of a configuration of a watched project, or an error of a snapshot.
+type WatchEvent struct {
+	// What changed. One of the WatchEvent_Type_* values.
+	Type string `json:"type"`
+
+	// The unique project ID.
+	ProjectID string `json:"project_id"`
+
+	// The unique configuration ID. It is empty for errors.
+	ConfigID string `json:"config_id,omitempty"`
+
+	// The name of the configuration.
+	ConfigName string `json:"config_name,omitempty"`
+
+	// The version number of the configuration.
+	Version int64 `json:"version,omitempty"`
+
+	// The state of the configuration in the previous snapshot. It is only set for state changes.
+	PreviousState string `json:"previous_state,omitempty"`
+
+	// The state of the configuration.
+	State string `json:"state,omitempty"`
+
+	// The new event that requires human action. It is only set for needs attention events.
+	NeedsAttention *ProjectConfigNeedsAttentionState `json:"needs_attention,omitempty"`
+
+	// The deploy action that finished. It is only set for deploy events.
+	Deployment *LastActionWithSummary `json:"deployment,omitempty"`
+
+	// The drift that the last monitoring action found. It is only set for drift events.
+	Drift *ConfigDriftReport `json:"drift,omitempty"`
+
+	// The configuration as it was retrieved, or as it was last seen for a deleted configuration.
+	Config *ProjectConfig `json:"config,omitempty"`
+
+	// The error of the snapshot. It is only set for errors; the watch goes on with the next snapshot.
+	Err error `json:"-"`
+
+	// The time of the snapshot that observed the change.
+	ObservedAt strfmt.DateTime `json:"observed_at"`
+}
+
+// Watch : Watch the configurations of a project for changes
+// See WatchWithContext. The watch ends when the timeout of the options expires; without a timeout, use
+// WatchWithContext to end it.
+func (project *ProjectV1) Watch(watchOptions *WatchOptions) (result <-chan WatchEvent, err error) {
+	result, err = project.WatchWithContext(context.Background(), watchOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// WatchWithContext : Watch the configurations of a project for changes
+// Take a snapshot of the configurations with ConfigsPager at every poll interval and send an event on the returned
+// channel for each change from the previous snapshot: a configuration was created or deleted, a new version was
+// created, its state changed, a deploy finished or failed, a new event requires human action, or the last monitoring
+// action found drift. The first snapshot is the baseline and produces no events. A configuration is only retrieved
+// again with GetConfig when its modification time, state or version changed, or when the cumulative needs attention
+// view of the project lists an event of it that was not seen. A snapshot that fails sends an error event and is taken
+// again at the next interval. The channel is closed when the context is done or the timeout expires.
+func (project *ProjectV1) WatchWithContext(ctx context.Context, watchOptions *WatchOptions) (result <-chan WatchEvent, err error) {
+	err = core.ValidateNotNil(watchOptions, "watchOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(watchOptions, "watchOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	var cancel context.CancelFunc = func() {}
+	if watchOptions.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, watchOptions.Timeout)
+	}
+	interval := watchOptions.PollInterval
+	if interval <= 0 {
+		interval = DefaultWatchPollInterval
+	}
+	events := make(chan WatchEvent)
+	watcher := &configWatcher{
+		project:   project,
+		projectID: *watchOptions.ProjectID,
+		headers:   watchOptions.Headers,
+	}
+	go func() {
+		defer close(events)
+		defer cancel()
+
+		backoff := newPollBackoff(interval, interval, 1)
+		for {
+			changes, snapshotErr := watcher.snapshot(ctx)
+			if snapshotErr != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				changes = []WatchEvent{{
+					Type:       WatchEvent_Type_Error,
+					ProjectID:  watcher.projectID,
+					Err:        snapshotErr,
+					ObservedAt: strfmt.DateTime(time.Now().UTC()),
+				}}
+			}
+			for _, change := range changes {
+				select {
+				case events <- change:
+				case <-ctx.Done():
+					return
+				}
+			}
+			if backoff.wait(ctx) != nil {
+				return
+			}
+		}
+	}()
+	result = events
+	return
+}
+
+// configWatcher takes the snapshots of Watch.
+type configWatcher struct {
+	project   *ProjectV1
+	projectID string
+	headers   map[string]string
+
+	// The configurations of the last snapshot, by ID, and their IDs in the order in which they were listed. The map is
+	// nil until a snapshot succeeds.
+	configs map[string]*ProjectConfig
+	order   []string
+}
+
+// snapshot lists the configurations and returns the changes from the previous snapshot. The previous snapshot is kept
+// if an error is returned.
+func (watcher *configWatcher) snapshot(ctx context.Context) (changes []WatchEvent, err error) {
+	projectID := core.StringPtr(watcher.projectID)
+	live, _, err := watcher.project.GetProjectWithContext(ctx, &GetProjectOptions{ID: projectID, Headers: watcher.headers})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-project-error")
+		return
+	}
+	configsPager, err := watcher.project.NewConfigsPager(&ListConfigsOptions{ProjectID: projectID, Headers: watcher.headers})
+	if err != nil {
+		return
+	}
+	summaries, err := configsPager.GetAllWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-configs-error")
+		return
+	}
+	viewEvents := make(map[string][]string)
+	for _, event := range live.CumulativeNeedsAttentionView {
+		configID := core.StringNilMapper(event.ConfigID)
+		viewEvents[configID] = append(viewEvents[configID], core.StringNilMapper(event.EventID))
+	}
+
+	observedAt := strfmt.DateTime(time.Now().UTC())
+	configs := make(map[string]*ProjectConfig, len(summaries))
+	order := make([]string, 0, len(summaries))
+	for i := range summaries {
+		summary := &summaries[i]
+		configID := core.StringNilMapper(summary.ID)
+		previous := watcher.configs[configID]
+		config := previous
+		if previous == nil || configModified(previous, summary) || hasUnseenNeedsAttention(previous, viewEvents[configID]) {
+			config, _, err = watcher.project.GetConfigWithContext(ctx, &GetConfigOptions{ProjectID: projectID, ID: summary.ID, Headers: watcher.headers})
+			if err != nil {
+				err = core.RepurposeSDKProblem(err, "get-config-error")
+				return
+			}
+		}
+		configs[configID] = config
+		order = append(order, configID)
+		if watcher.configs == nil || config == previous {
+			continue
+		}
+		if previous == nil {
+			changes = append(changes, watcher.event(WatchEvent_Type_ConfigCreated, config, observedAt))
+			continue
+		}
+		changes = append(changes, watcher.configChanges(previous, config, observedAt)...)
+	}
+	if watcher.configs != nil {
+		for _, configID := range watcher.order {
+			if _, found := configs[configID]; !found {
+				changes = append(changes, watcher.event(WatchEvent_Type_ConfigDeleted, watcher.configs[configID], observedAt))
+			}
+		}
+	}
+	watcher.configs = configs
+	watcher.order = order
+	return
+}
+
+// configChanges returns the changes between two retrievals of a configuration.
+func (watcher *configWatcher) configChanges(previous *ProjectConfig, config *ProjectConfig, observedAt strfmt.DateTime) (changes []WatchEvent) {
+	if int64Value(config.Version) > int64Value(previous.Version) {
+		changes = append(changes, watcher.event(WatchEvent_Type_VersionCreated, config, observedAt))
+	}
+	if previousState := core.StringNilMapper(previous.State); previousState != core.StringNilMapper(config.State) {
+		change := watcher.event(WatchEvent_Type_StateChanged, config, observedAt)
+		change.PreviousState = previousState
+		changes = append(changes, change)
+	}
+
+	if deployment := config.LastDeployed; deployment != nil && lastActionKey(deployment) != lastActionKey(previous.LastDeployed) {
+		var change WatchEvent
+		switch core.StringNilMapper(deployment.Result) {
+		case LastActionWithSummary_Result_Succeeded:
+			change = watcher.event(WatchEvent_Type_DeployFinished, config, observedAt)
+		case LastActionWithSummary_Result_Failed:
+			change = watcher.event(WatchEvent_Type_DeployFailed, config, observedAt)
+		}
+		if change.Type != "" {
+			change.Deployment = deployment
+			changes = append(changes, change)
+		}
+	}
+
+	seen := make(map[string]bool)
+	for i := range previous.NeedsAttentionState {
+		seen[needsAttentionKey(&previous.NeedsAttentionState[i])] = true
+	}
+	for i := range config.NeedsAttentionState {
+		event := &config.NeedsAttentionState[i]
+		if key := needsAttentionKey(event); !seen[key] {
+			seen[key] = true
+			change := watcher.event(WatchEvent_Type_NeedsAttention, config, observedAt)
+			change.NeedsAttention = event
+			changes = append(changes, change)
+		}
+	}
+
+	drift := configDriftReport(watcher.projectID, config)
+	if drift.Status == ConfigDriftReport_Status_Drifted {
+		previousDrift := configDriftReport(watcher.projectID, previous)
+		if previousDrift.Status != ConfigDriftReport_Status_Drifted || previousDrift.JobID != drift.JobID {
+			change := watcher.event(WatchEvent_Type_DriftDetected, config, observedAt)
+			change.Drift = &drift
+			changes = append(changes, change)
+		}
+	}
+	return
+}
+
+// event returns an event of a configuration.
+func (watcher *configWatcher) event(eventType string, config *ProjectConfig, observedAt strfmt.DateTime) WatchEvent {
+	event := WatchEvent{
+		Type:       eventType,
+		ProjectID:  watcher.projectID,
+		ConfigID:   core.StringNilMapper(config.ID),
+		Version:    int64Value(config.Version),
+		State:      core.StringNilMapper(config.State),
+		Config:     config,
+		ObservedAt: observedAt,
+	}
+	if definition := definitionResponse(config.Definition); definition != nil {
+		event.ConfigName = core.StringNilMapper(definition.Name)
+	}
+	return event
+}
+
+// configModified returns true if the summary of a configuration differs from its last retrieval.
+func configModified(config *ProjectConfig, summary *ProjectConfigSummary) bool {
+	if config.ModifiedAt == nil || summary.ModifiedAt == nil {
+		return true
+	}
+	return !time.Time(*config.ModifiedAt).Equal(time.Time(*summary.ModifiedAt)) ||
+		core.StringNilMapper(config.State) != core.StringNilMapper(summary.State) ||
+		int64Value(config.Version) != int64Value(summary.Version)
+}
+
+// hasUnseenNeedsAttention returns true if an event ID is not in the needs attention state of a configuration.
+func hasUnseenNeedsAttention(config *ProjectConfig, eventIDs []string) bool {
+	seen := make(map[string]bool)
+	for _, event := range config.NeedsAttentionState {
+		seen[core.StringNilMapper(event.EventID)] = true
+	}
+	for _, eventID := range eventIDs {
+		if !seen[eventID] {
+			return true
+		}
+	}
+	return false
+}
+
+// needsAttentionKey identifies an event by its ID, or by its name and time if it has no ID.
+func needsAttentionKey(event *ProjectConfigNeedsAttentionState) string {
+	if event.EventID != nil && *event.EventID != "" {
+		return *event.EventID
+	}
+	key := core.StringNilMapper(event.Event)
+	if event.Timestamp != nil {
+		key += "@" + event.Timestamp.String()
+	}
+	return key
+}
+
+// lastActionKey identifies an action by its job, or by its version and result if it has no job.
+func lastActionKey(action *LastActionWithSummary) string {
+	if action == nil {
+		return ""
+	}
+	if action.Job != nil && action.Job.ID != nil {
+		return *action.Job.ID
+	}
+	return core.StringNilMapper(action.Href) + "#" + core.StringNilMapper(action.Result)
+}
@@ -0,0 +1,196 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/project-go-sdk/projectv1"
+	"github.com/IBM/project-go-sdk/projectv1fake"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Watch`, func() {
+	var server *projectv1fake.Server
+	var projectService *projectv1.ProjectV1
+	var projectID string
+	var configID string
+	var ctx context.Context
+	var cancel context.CancelFunc
+	var events <-chan projectv1.WatchEvent
+
+	createConfig := func(name string) string {
+		config, _, err := projectService.CreateConfig(projectService.NewCreateConfigOptions(projectID,
+			&projectv1.ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype{Name: core.StringPtr(name)}))
+		Expect(err).To(BeNil())
+		return *config.ID
+	}
+	// next returns the next event, and fails if it is not of a type.
+	next := func(eventType string) projectv1.WatchEvent {
+		var event projectv1.WatchEvent
+		Eventually(events, 2*time.Second).Should(Receive(&event))
+		Expect(event.Type).To(Equal(eventType))
+		return event
+	}
+
+	BeforeEach(func() {
+		var err error
+		server = projectv1fake.NewServer()
+		projectService, err = server.NewProjectV1()
+		Expect(err).To(BeNil())
+		project, _, err := projectService.CreateProject(projectService.NewCreateProjectOptions(
+			&projectv1.ProjectPrototypeDefinition{Name: core.StringPtr("my-project")}, "us-south", "Default"))
+		Expect(err).To(BeNil())
+		projectID = *project.ID
+		configID = createConfig("network")
+
+		ctx, cancel = context.WithCancel(context.Background())
+		events, err = projectService.WatchWithContext(ctx, projectService.NewWatchOptions(projectID).
+			SetPollInterval(5*time.Millisecond))
+		Expect(err).To(BeNil())
+		Consistently(events, 30*time.Millisecond).ShouldNot(Receive())
+	})
+	AfterEach(func() {
+		cancel()
+		Eventually(events).Should(BeClosed())
+		server.Close()
+	})
+
+	It(`Sends the state transitions of the configurations`, func() {
+		_, _, err := projectService.ValidateConfig(projectService.NewValidateConfigOptions(projectID, configID))
+		Expect(err).To(BeNil())
+		event := next(projectv1.WatchEvent_Type_StateChanged)
+		Expect(event.ProjectID).To(Equal(projectID))
+		Expect(event.ConfigID).To(Equal(configID))
+		Expect(event.ConfigName).To(Equal("network"))
+		Expect(event.Version).To(Equal(int64(1)))
+		Expect(event.PreviousState).To(Equal(projectv1.ProjectConfig_State_Draft))
+		Expect(event.State).To(Equal(projectv1.ProjectConfig_State_Validated))
+		Expect(*event.Config.State).To(Equal(projectv1.ProjectConfig_State_Validated))
+
+		_, _, err = projectService.Approve(projectService.NewApproveOptions(projectID, configID))
+		Expect(err).To(BeNil())
+		Expect(next(projectv1.WatchEvent_Type_StateChanged).State).To(Equal(projectv1.ProjectConfig_State_Approved))
+
+		_, _, err = projectService.DeployConfig(projectService.NewDeployConfigOptions(projectID, configID))
+		Expect(err).To(BeNil())
+		Expect(next(projectv1.WatchEvent_Type_StateChanged).State).To(Equal(projectv1.ProjectConfig_State_Deployed))
+		event = next(projectv1.WatchEvent_Type_DeployFinished)
+		Expect(*event.Deployment.Result).To(Equal(projectv1.LastActionWithSummary_Result_Succeeded))
+
+		_, _, err = projectService.UpdateConfig(projectService.NewUpdateConfigOptions(projectID, configID,
+			&projectv1.ProjectConfigDefinitionPatch{Inputs: map[string]interface{}{"region": "eu-de"}}))
+		Expect(err).To(BeNil())
+		Expect(next(projectv1.WatchEvent_Type_VersionCreated).Version).To(Equal(int64(2)))
+		Expect(next(projectv1.WatchEvent_Type_StateChanged).State).To(Equal(projectv1.ProjectConfig_State_Draft))
+
+		server.FailAction(configID, projectv1fake.ActionValidate)
+		_, _, err = projectService.ValidateConfig(projectService.NewValidateConfigOptions(projectID, configID))
+		Expect(err).To(BeNil())
+		Expect(next(projectv1.WatchEvent_Type_StateChanged).State).To(Equal(projectv1.ProjectConfig_State_ValidatingFailed))
+		_, _, err = projectService.ForceApprove(projectService.NewForceApproveOptions(projectID, configID, "known issue"))
+		Expect(err).To(BeNil())
+		Expect(next(projectv1.WatchEvent_Type_StateChanged).State).To(Equal(projectv1.ProjectConfig_State_Approved))
+		server.FailAction(configID, projectv1fake.ActionDeploy)
+		_, _, err = projectService.DeployConfig(projectService.NewDeployConfigOptions(projectID, configID))
+		Expect(err).To(BeNil())
+		Expect(next(projectv1.WatchEvent_Type_StateChanged).State).To(Equal(projectv1.ProjectConfig_State_DeployingFailed))
+		event = next(projectv1.WatchEvent_Type_DeployFailed)
+		Expect(event.Version).To(Equal(int64(2)))
+		Expect(*event.Deployment.Result).To(Equal(projectv1.LastActionWithSummary_Result_Failed))
+		Consistently(events, 30*time.Millisecond).ShouldNot(Receive())
+	})
+	It(`Sends the created and deleted configurations`, func() {
+		otherID := createConfig("database")
+		event := next(projectv1.WatchEvent_Type_ConfigCreated)
+		Expect(event.ConfigID).To(Equal(otherID))
+		Expect(event.ConfigName).To(Equal("database"))
+		Expect(event.State).To(Equal(projectv1.ProjectConfig_State_Draft))
+
+		_, _, err := projectService.DeleteConfig(projectService.NewDeleteConfigOptions(projectID, configID))
+		Expect(err).To(BeNil())
+		event = next(projectv1.WatchEvent_Type_ConfigDeleted)
+		Expect(event.ConfigID).To(Equal(configID))
+		Expect(event.ConfigName).To(Equal("network"))
+		Consistently(events, 30*time.Millisecond).ShouldNot(Receive())
+	})
+	It(`Sends the new needs attention events and drift of unmodified configurations`, func() {
+		server.SetLastMonitoring(configID, &projectv1.LastMonitoringActionWithSummary{
+			Href:   core.StringPtr("https://schematics.cloud.ibm.com/v2/jobs/job-1"),
+			Result: core.StringPtr(projectv1.LastMonitoringActionWithSummary_Result_Succeeded),
+			DriftDetection: &projectv1.LastDriftDetectionJobSummary{
+				Job: &projectv1.ActionJobWithIdAndSummary{
+					ID: core.StringPtr("job-1"),
+					Summary: &projectv1.ActionJobSummary{PlanSummary: &projectv1.ActionJobPlanSummary{
+						Update:           core.Int64Ptr(1),
+						UpdatedResources: []string{"ibm_is_vpc.vpc"},
+					}},
+				},
+			},
+		})
+		server.AddNeedsAttention(configID, projectv1.ProjectConfigNeedsAttentionState{
+			EventID:  core.StringPtr("event-1"),
+			Event:    core.StringPtr("project.config.drift_detected"),
+			Severity: core.StringPtr(projectv1.ProjectConfigNeedsAttentionState_Severity_Warning),
+		})
+
+		event := next(projectv1.WatchEvent_Type_NeedsAttention)
+		Expect(event.ConfigID).To(Equal(configID))
+		Expect(*event.NeedsAttention.EventID).To(Equal("event-1"))
+		event = next(projectv1.WatchEvent_Type_DriftDetected)
+		Expect(event.Drift.Status).To(Equal(projectv1.ConfigDriftReport_Status_Drifted))
+		Expect(event.Drift.JobID).To(Equal("job-1"))
+		Expect(event.Drift.Resources).To(Equal([]projectv1.DriftedResource{{Address: "ibm_is_vpc.vpc", Change: projectv1.DriftedResource_Change_Update}}))
+		Consistently(events, 30*time.Millisecond).ShouldNot(Receive())
+	})
+	It(`Sends the errors of the snapshots and goes on`, func() {
+		server.InjectError("GET", "/v1/projects/"+projectID, 500, "boom")
+		event := next(projectv1.WatchEvent_Type_Error)
+		Expect(event.ProjectID).To(Equal(projectID))
+		Expect(event.Err.Error()).To(ContainSubstring("boom"))
+
+		createConfig("database")
+		next(projectv1.WatchEvent_Type_ConfigCreated)
+	})
+	It(`Closes the channel when the timeout expires`, func() {
+		watched, err := projectService.Watch(projectService.NewWatchOptions(projectID).
+			SetPollInterval(5 * time.Millisecond).
+			SetTimeout(20 * time.Millisecond))
+		Expect(err).To(BeNil())
+		Eventually(watched).Should(BeClosed())
+	})
+	It(`Invoke Watch with error: Param validation error`, func() {
+		_, err := projectService.Watch(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = projectService.Watch(new(projectv1.WatchOptions))
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Invoke NewWatchOptions successfully`, func() {
+		options := projectService.NewWatchOptions("testString").
+			SetProjectID("project").
+			SetPollInterval(time.Second).
+			SetTimeout(time.Hour).
+			SetHeaders(map[string]string{"foo": "bar"})
+		Expect(*options.ProjectID).To(Equal("project"))
+		Expect(options.PollInterval).To(Equal(time.Second))
+		Expect(options.Timeout).To(Equal(time.Hour))
+		Expect(options.Headers).To(Equal(map[string]string{"foo": "bar"}))
+	})
+})
@@ -0,0 +1,403 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/project-go-sdk/common"
+)
+
+// ErrCostCurrencyMismatch is the cause of the errors returned when cost estimates in different currencies are added.
+// Check for it with errors.Is.
+var ErrCostCurrencyMismatch = errors.New("cost estimates are in different currencies")
+
+// costAmountPattern matches the decimal numbers that the service uses for costs, such as "52.58".
+var costAmountPattern = regexp.MustCompile(`^[+-]?[0-9]+(\.[0-9]+)?$`)
+
+// CostAmount : An exact decimal amount of money.
+// The zero value is an amount of 0. Amounts are immutable, and are encoded in JSON as decimal strings like the costs of
+// the service.
+type CostAmount struct {
+	value *big.Rat
+
+	// The number of decimal places of the amount, which is the largest number of decimal places of the amounts that it
+	// is computed from.
+	scale int
+}
+
+// ParseCostAmount : Parse a decimal amount, such as "52.58"
+func ParseCostAmount(amount string) (CostAmount, error) {
+	amount = strings.TrimSpace(amount)
+	if !costAmountPattern.MatchString(amount) {
+		return CostAmount{}, core.SDKErrorf(nil, fmt.Sprintf("'%s' is not a decimal amount", amount), "invalid-cost-amount", common.GetComponentInfo())
+	}
+	value, _ := new(big.Rat).SetString(amount)
+	scale := 0
+	if index := strings.IndexByte(amount, '.'); index >= 0 {
+		scale = len(amount) - index - 1
+	}
+	return CostAmount{value: value, scale: scale}, nil
+}
+
+func (amount CostAmount) rat() *big.Rat {
+	if amount.value == nil {
+		return new(big.Rat)
+	}
+	return amount.value
+}
+
+// Add returns the sum of two amounts.
+func (amount CostAmount) Add(other CostAmount) CostAmount {
+	return CostAmount{value: new(big.Rat).Add(amount.rat(), other.rat()), scale: maxScale(amount, other)}
+}
+
+// Sub returns the difference of two amounts.
+func (amount CostAmount) Sub(other CostAmount) CostAmount {
+	return CostAmount{value: new(big.Rat).Sub(amount.rat(), other.rat()), scale: maxScale(amount, other)}
+}
+
+// Cmp compares two amounts and returns -1, 0 or +1.
+func (amount CostAmount) Cmp(other CostAmount) int {
+	return amount.rat().Cmp(other.rat())
+}
+
+// Sign returns -1, 0 or +1 for a negative, zero or positive amount.
+func (amount CostAmount) Sign() int {
+	return amount.rat().Sign()
+}
+
+// Float64 returns the nearest float64 value of the amount.
+func (amount CostAmount) Float64() float64 {
+	value, _ := amount.rat().Float64()
+	return value
+}
+
+// String returns the amount as a decimal number with its number of decimal places.
+func (amount CostAmount) String() string {
+	return amount.rat().FloatString(amount.scale)
+}
+
+// MarshalJSON encodes the amount as a decimal string.
+func (amount CostAmount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(amount.String())
+}
+
+// UnmarshalJSON decodes an amount from a decimal string or number.
+func (amount *CostAmount) UnmarshalJSON(data []byte) (err error) {
+	var text string
+	if err = json.Unmarshal(data, &text); err != nil {
+		text = string(data)
+	}
+	*amount, err = ParseCostAmount(text)
+	return
+}
+
+func maxScale(amount CostAmount, other CostAmount) int {
+	if amount.scale > other.scale {
+		return amount.scale
+	}
+	return other.scale
+}
+
+// CostEstimate : A cost estimate with decimal amounts, parsed from a ProjectConfigMetadataCostEstimate or added up from
+// several estimates.
+type CostEstimate struct {
+	// The currency of the estimate, such as "USD". It is empty if the service did not report one.
+	Currency string `json:"currency,omitempty"`
+
+	// The total hourly cost.
+	HourlyCost CostAmount `json:"hourly_cost"`
+
+	// The total monthly cost.
+	MonthlyCost CostAmount `json:"monthly_cost"`
+
+	// The total hourly cost of the past estimate, which is the estimate of the deployed version.
+	PastHourlyCost CostAmount `json:"past_hourly_cost"`
+
+	// The total monthly cost of the past estimate.
+	PastMonthlyCost CostAmount `json:"past_monthly_cost"`
+
+	// The difference between the hourly costs of the estimate and of the past estimate.
+	DiffHourlyCost CostAmount `json:"diff_hourly_cost"`
+
+	// The difference between the monthly costs of the estimate and of the past estimate.
+	DiffMonthlyCost CostAmount `json:"diff_monthly_cost"`
+}
+
+// ParseCostEstimate : Parse the costs of a cost estimate into decimal amounts
+// The estimate can be the cost estimate of the last validation of a configuration or of a prevalidation. Costs that
+// are not reported are 0, except for the differences, which are computed from the costs and the past costs. The
+// result is nil if the estimate is nil.
+func ParseCostEstimate(estimate *ProjectConfigMetadataCostEstimate) (result *CostEstimate, err error) {
+	if estimate == nil {
+		return
+	}
+	parsed := &CostEstimate{Currency: core.StringNilMapper(estimate.Currency)}
+	for _, cost := range []struct {
+		name   string
+		value  *string
+		result *CostAmount
+	}{
+		{"totalHourlyCost", estimate.TotalHourlyCost, &parsed.HourlyCost},
+		{"totalMonthlyCost", estimate.TotalMonthlyCost, &parsed.MonthlyCost},
+		{"pastTotalHourlyCost", estimate.PastTotalHourlyCost, &parsed.PastHourlyCost},
+		{"pastTotalMonthlyCost", estimate.PastTotalMonthlyCost, &parsed.PastMonthlyCost},
+		{"diffTotalHourlyCost", estimate.DiffTotalHourlyCost, &parsed.DiffHourlyCost},
+		{"diffTotalMonthlyCost", estimate.DiffTotalMonthlyCost, &parsed.DiffMonthlyCost},
+	} {
+		if cost.value == nil || *cost.value == "" {
+			continue
+		}
+		*cost.result, err = ParseCostAmount(*cost.value)
+		if err != nil {
+			err = core.SDKErrorf(err, fmt.Sprintf("the %s of the cost estimate is not valid: %s", cost.name, err.Error()),
+				"invalid-cost-estimate", common.GetComponentInfo())
+			return
+		}
+	}
+	if estimate.DiffTotalHourlyCost == nil || *estimate.DiffTotalHourlyCost == "" {
+		parsed.DiffHourlyCost = parsed.HourlyCost.Sub(parsed.PastHourlyCost)
+	}
+	if estimate.DiffTotalMonthlyCost == nil || *estimate.DiffTotalMonthlyCost == "" {
+		parsed.DiffMonthlyCost = parsed.MonthlyCost.Sub(parsed.PastMonthlyCost)
+	}
+	return parsed, nil
+}
+
+// ConfigCostEstimate : Parse the cost estimate of the last validation of a configuration
+// The result is nil if the configuration has not been validated with a cost estimate.
+func ConfigCostEstimate(config *ProjectConfig) (*CostEstimate, error) {
+	if config == nil || config.LastValidated == nil {
+		return nil, nil
+	}
+	return ParseCostEstimate(config.LastValidated.CostEstimate)
+}
+
+// Add returns the sum of two estimates. An estimate without a currency can be added to an estimate in any currency;
+// an error is returned for estimates in different currencies.
+func (estimate CostEstimate) Add(other CostEstimate) (CostEstimate, error) {
+	currency := estimate.Currency
+	if currency == "" {
+		currency = other.Currency
+	} else if other.Currency != "" && other.Currency != currency {
+		return estimate, core.SDKErrorf(ErrCostCurrencyMismatch, fmt.Sprintf("cannot add a cost estimate in %s to a cost estimate in %s", other.Currency, currency),
+			"cost-currency-mismatch", common.GetComponentInfo())
+	}
+	return CostEstimate{
+		Currency:        currency,
+		HourlyCost:      estimate.HourlyCost.Add(other.HourlyCost),
+		MonthlyCost:     estimate.MonthlyCost.Add(other.MonthlyCost),
+		PastHourlyCost:  estimate.PastHourlyCost.Add(other.PastHourlyCost),
+		PastMonthlyCost: estimate.PastMonthlyCost.Add(other.PastMonthlyCost),
+		DiffHourlyCost:  estimate.DiffHourlyCost.Add(other.DiffHourlyCost),
+		DiffMonthlyCost: estimate.DiffMonthlyCost.Add(other.DiffMonthlyCost),
+	}, nil
+}
+
+// GetProjectCostsOptions : The GetProjectCosts options.
+type GetProjectCostsOptions struct {
+	// The unique project ID.
+	ProjectID *string `json:"project_id" validate:"required,ne="`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewGetProjectCostsOptions : Instantiate GetProjectCostsOptions
+func (*ProjectV1) NewGetProjectCostsOptions(projectID string) *GetProjectCostsOptions {
+	return &GetProjectCostsOptions{
+		ProjectID: core.StringPtr(projectID),
+	}
+}
+
+// SetProjectID : Allow user to set ProjectID
+func (_options *GetProjectCostsOptions) SetProjectID(projectID string) *GetProjectCostsOptions {
+	_options.ProjectID = core.StringPtr(projectID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *GetProjectCostsOptions) SetHeaders(param map[string]string) *GetProjectCostsOptions {
+	options.Headers = param
+	return options
+}
+
+// ConfigCost : The cost estimate of a configuration.
+type ConfigCost struct {
+	// The unique configuration ID.
+	ConfigID string `json:"config_id"`
+
+	// The name of the configuration.
+	ConfigName string `json:"config_name"`
+
+	// The version of the configuration that the estimate is for.
+	Version int64 `json:"version"`
+
+	// The ID of the environment of the configuration, or of its stack if the configuration has none.
+	EnvironmentID string `json:"environment_id,omitempty"`
+
+	// The ID of the stack configuration that the configuration is a member of.
+	StackID string `json:"stack_id,omitempty"`
+
+	// Whether the configuration is a stack.
+	IsStack bool `json:"is_stack"`
+
+	// The cost estimate of the last validation of the configuration. It is nil if the configuration has not been
+	// validated with a cost estimate.
+	Estimate *CostEstimate `json:"estimate,omitempty"`
+}
+
+// ProjectCosts : The cost estimates of the configurations of a project, rolled up per environment and per stack.
+// The estimates of stack configurations are not rolled up, because the estimates of their members are.
+type ProjectCosts struct {
+	// The unique project ID.
+	ProjectID string `json:"project_id"`
+
+	// The sum of the estimates of the configurations that are not stacks.
+	Total CostEstimate `json:"total"`
+
+	// The sums of the estimates of the configurations that are not stacks, by environment ID.
+	Environments map[string]CostEstimate `json:"environments"`
+
+	// The sums of the estimates of the members of the stacks, by stack configuration ID.
+	Stacks map[string]CostEstimate `json:"stacks"`
+
+	// The configurations, in the order in which the service lists them.
+	Configs []ConfigCost `json:"configs"`
+}
+
+// Unestimated returns the configurations that have no cost estimate, which are not included in the totals.
+func (costs *ProjectCosts) Unestimated() []ConfigCost {
+	var unestimated []ConfigCost
+	for _, config := range costs.Configs {
+		if config.Estimate == nil {
+			unestimated = append(unestimated, config)
+		}
+	}
+	return unestimated
+}
+
+// GetProjectCosts : Roll up the cost estimates of a project
+// Parse the cost estimates of the last validations of the configurations of a project and add them up for the project,
+// per environment and per stack. The differences with the past estimates are the monthly and hourly deltas that
+// approving and deploying the validated versions would cause. An error is returned if the estimates are in different
+// currencies.
+func (project *ProjectV1) GetProjectCosts(getProjectCostsOptions *GetProjectCostsOptions) (result *ProjectCosts, err error) {
+	result, err = project.GetProjectCostsWithContext(context.Background(), getProjectCostsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetProjectCostsWithContext is an alternate form of the GetProjectCosts method which supports a Context parameter
+func (project *ProjectV1) GetProjectCostsWithContext(ctx context.Context, getProjectCostsOptions *GetProjectCostsOptions) (result *ProjectCosts, err error) {
+	err = core.ValidateNotNil(getProjectCostsOptions, "getProjectCostsOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(getProjectCostsOptions, "getProjectCostsOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	projectID := getProjectCostsOptions.ProjectID
+	headers := getProjectCostsOptions.Headers
+
+	configsPager, err := project.NewConfigsPager(&ListConfigsOptions{ProjectID: projectID, Headers: headers})
+	if err != nil {
+		return
+	}
+	summaries, err := configsPager.GetAllWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-configs-error")
+		return
+	}
+
+	result = &ProjectCosts{
+		ProjectID:    *projectID,
+		Environments: make(map[string]CostEstimate),
+		Stacks:       make(map[string]CostEstimate),
+		Configs:      make([]ConfigCost, 0, len(summaries)),
+	}
+	stackIDs := make(map[string]string)
+	stackEnvironmentIDs := make(map[string]string)
+	for _, summary := range summaries {
+		var config *ProjectConfig
+		config, _, err = project.GetConfigWithContext(ctx, &GetConfigOptions{ProjectID: projectID, ID: summary.ID, Headers: headers})
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "get-config-error")
+			return
+		}
+		cost := ConfigCost{
+			ConfigID: core.StringNilMapper(config.ID),
+			Version:  *config.Version,
+			IsStack:  isStackConfig(config),
+		}
+		if definition := definitionResponse(config.Definition); definition != nil {
+			cost.ConfigName = core.StringNilMapper(definition.Name)
+			cost.EnvironmentID = core.StringNilMapper(definition.EnvironmentID)
+			for _, member := range definition.Members {
+				stackIDs[core.StringNilMapper(member.ConfigID)] = cost.ConfigID
+			}
+			if cost.IsStack {
+				stackEnvironmentIDs[cost.ConfigID] = cost.EnvironmentID
+			}
+		}
+		if config.MemberOf != nil {
+			cost.StackID = core.StringNilMapper(config.MemberOf.ID)
+		}
+		cost.Estimate, err = ConfigCostEstimate(config)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "config-cost-estimate-error")
+			return
+		}
+		result.Configs = append(result.Configs, cost)
+	}
+
+	for i := range result.Configs {
+		cost := &result.Configs[i]
+		if cost.StackID == "" {
+			cost.StackID = stackIDs[cost.ConfigID]
+		}
+		if cost.EnvironmentID == "" && cost.StackID != "" {
+			cost.EnvironmentID = stackEnvironmentIDs[cost.StackID]
+		}
+		if cost.IsStack || cost.Estimate == nil {
+			continue
+		}
+		result.Total, err = result.Total.Add(*cost.Estimate)
+		if err == nil && cost.EnvironmentID != "" {
+			result.Environments[cost.EnvironmentID], err = result.Environments[cost.EnvironmentID].Add(*cost.Estimate)
+		}
+		if err == nil && cost.StackID != "" {
+			result.Stacks[cost.StackID], err = result.Stacks[cost.StackID].Add(*cost.Estimate)
+		}
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "cost-rollup-error")
+			return
+		}
+	}
+	return
+}
@@ -0,0 +1,220 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/project-go-sdk/projectv1"
+	"github.com/IBM/project-go-sdk/projectv1fake"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Cost estimates`, func() {
+	amount := func(value string) projectv1.CostAmount {
+		parsed, err := projectv1.ParseCostAmount(value)
+		Expect(err).To(BeNil())
+		return parsed
+	}
+
+	It(`Parses and adds decimal amounts exactly`, func() {
+		sum := amount("0.1").Add(amount("0.2"))
+		Expect(sum.String()).To(Equal("0.3"))
+		Expect(sum.Cmp(amount("0.30"))).To(Equal(0))
+		Expect(amount("52.58").Sub(amount("60.0")).String()).To(Equal("-7.42"))
+		Expect(amount("-7.42").Sign()).To(Equal(-1))
+		Expect(amount("12.5").Float64()).To(Equal(12.5))
+		Expect(projectv1.CostAmount{}.String()).To(Equal("0"))
+		Expect(projectv1.CostAmount{}.Add(amount("1.25")).String()).To(Equal("1.25"))
+
+		for _, invalid := range []string{"", "abc", "1e3", "1,000.00", "$5"} {
+			_, err := projectv1.ParseCostAmount(invalid)
+			Expect(err).ToNot(BeNil(), invalid)
+		}
+
+		data, err := json.Marshal(amount("1.50"))
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(Equal(`"1.50"`))
+		var decoded projectv1.CostAmount
+		Expect(json.Unmarshal([]byte(`"3.25"`), &decoded)).To(Succeed())
+		Expect(decoded.String()).To(Equal("3.25"))
+		Expect(json.Unmarshal([]byte(`4.5`), &decoded)).To(Succeed())
+		Expect(decoded.String()).To(Equal("4.5"))
+	})
+	It(`Parses cost estimates`, func() {
+		estimate, err := projectv1.ParseCostEstimate(&projectv1.ProjectConfigMetadataCostEstimate{
+			Currency:             core.StringPtr("USD"),
+			TotalHourlyCost:      core.StringPtr("0.072"),
+			TotalMonthlyCost:     core.StringPtr("52.58"),
+			PastTotalMonthlyCost: core.StringPtr("40.00"),
+			DiffTotalMonthlyCost: core.StringPtr("12.58"),
+		})
+		Expect(err).To(BeNil())
+		Expect(estimate.Currency).To(Equal("USD"))
+		Expect(estimate.HourlyCost.String()).To(Equal("0.072"))
+		Expect(estimate.MonthlyCost.String()).To(Equal("52.58"))
+		Expect(estimate.PastMonthlyCost.String()).To(Equal("40.00"))
+		Expect(estimate.DiffMonthlyCost.String()).To(Equal("12.58"))
+		Expect(estimate.DiffHourlyCost.String()).To(Equal("0.072"))
+
+		estimate, err = projectv1.ParseCostEstimate(nil)
+		Expect(err).To(BeNil())
+		Expect(estimate).To(BeNil())
+
+		_, err = projectv1.ParseCostEstimate(&projectv1.ProjectConfigMetadataCostEstimate{TotalMonthlyCost: core.StringPtr("n/a")})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(Equal("the totalMonthlyCost of the cost estimate is not valid: 'n/a' is not a decimal amount"))
+
+		_, err = projectv1.CostEstimate{Currency: "USD"}.Add(projectv1.CostEstimate{Currency: "EUR"})
+		Expect(errors.Is(err, projectv1.ErrCostCurrencyMismatch)).To(BeTrue())
+		sum, err := projectv1.CostEstimate{}.Add(projectv1.CostEstimate{Currency: "EUR", MonthlyCost: amount("1")})
+		Expect(err).To(BeNil())
+		Expect(sum.Currency).To(Equal("EUR"))
+	})
+
+	Describe(`GetProjectCosts`, func() {
+		var server *projectv1fake.Server
+		var projectService *projectv1.ProjectV1
+		var projectID string
+		var devID string
+		var prodID string
+
+		createConfig := func(definition *projectv1.ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype,
+			estimate *projectv1.ProjectConfigMetadataCostEstimate) string {
+			config, _, err := projectService.CreateConfig(projectService.NewCreateConfigOptions(projectID, definition))
+			Expect(err).To(BeNil())
+			if estimate != nil {
+				server.SetCostEstimate(*config.ID, estimate)
+				_, _, err = projectService.ValidateConfig(projectService.NewValidateConfigOptions(projectID, *config.ID))
+				Expect(err).To(BeNil())
+			}
+			return *config.ID
+		}
+		estimate := func(monthly string, past string) *projectv1.ProjectConfigMetadataCostEstimate {
+			return &projectv1.ProjectConfigMetadataCostEstimate{
+				Currency:             core.StringPtr("USD"),
+				TotalMonthlyCost:     core.StringPtr(monthly),
+				PastTotalMonthlyCost: core.StringPtr(past),
+			}
+		}
+
+		BeforeEach(func() {
+			var err error
+			server = projectv1fake.NewServer()
+			projectService, err = server.NewProjectV1()
+			Expect(err).To(BeNil())
+
+			project, _, err := projectService.CreateProject(projectService.NewCreateProjectOptions(
+				&projectv1.ProjectPrototypeDefinition{Name: core.StringPtr("my-project")}, "us-south", "Default"))
+			Expect(err).To(BeNil())
+			projectID = *project.ID
+			for _, name := range []string{"dev", "prod"} {
+				environment, _, err := projectService.CreateProjectEnvironment(projectService.NewCreateProjectEnvironmentOptions(projectID,
+					&projectv1.EnvironmentDefinitionRequiredProperties{Name: core.StringPtr(name)}))
+				Expect(err).To(BeNil())
+				if name == "dev" {
+					devID = *environment.ID
+				} else {
+					prodID = *environment.ID
+				}
+			}
+		})
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It(`Rolls up the estimates per project, environment and stack`, func() {
+			networkID := createConfig(&projectv1.ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype{
+				Name: core.StringPtr("network"),
+			}, estimate("100.10", "100.10"))
+			clusterID := createConfig(&projectv1.ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype{
+				Name: core.StringPtr("cluster"),
+			}, estimate("250.00", "200.00"))
+			stackID := createConfig(&projectv1.ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype{
+				Name:          core.StringPtr("platform"),
+				EnvironmentID: core.StringPtr(prodID),
+				Members: []projectv1.StackMember{
+					{Name: core.StringPtr("network"), ConfigID: core.StringPtr(networkID)},
+					{Name: core.StringPtr("cluster"), ConfigID: core.StringPtr(clusterID)},
+				},
+			}, estimate("999.99", "0"))
+			devAppID := createConfig(&projectv1.ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype{
+				Name:          core.StringPtr("app"),
+				EnvironmentID: core.StringPtr(devID),
+			}, estimate("20.5", "30"))
+			draftID := createConfig(&projectv1.ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype{
+				Name:          core.StringPtr("draft"),
+				EnvironmentID: core.StringPtr(devID),
+			}, nil)
+
+			costs, err := projectService.GetProjectCosts(projectService.NewGetProjectCostsOptions(projectID))
+			Expect(err).To(BeNil())
+			Expect(costs.ProjectID).To(Equal(projectID))
+			Expect(costs.Total.Currency).To(Equal("USD"))
+			Expect(costs.Total.MonthlyCost.String()).To(Equal("370.60"))
+			Expect(costs.Total.PastMonthlyCost.String()).To(Equal("330.10"))
+			Expect(costs.Total.DiffMonthlyCost.String()).To(Equal("40.50"))
+
+			Expect(costs.Environments).To(HaveLen(2))
+			Expect(costs.Environments[prodID].MonthlyCost.String()).To(Equal("350.10"))
+			Expect(costs.Environments[prodID].DiffMonthlyCost.String()).To(Equal("50.00"))
+			Expect(costs.Environments[devID].MonthlyCost.String()).To(Equal("20.5"))
+			Expect(costs.Environments[devID].DiffMonthlyCost.String()).To(Equal("-9.5"))
+
+			Expect(costs.Stacks).To(HaveLen(1))
+			Expect(costs.Stacks[stackID].MonthlyCost.String()).To(Equal("350.10"))
+
+			Expect(costs.Configs).To(HaveLen(5))
+			Expect(costs.Configs[0].ConfigName).To(Equal("network"))
+			Expect(costs.Configs[0].StackID).To(Equal(stackID))
+			Expect(costs.Configs[0].EnvironmentID).To(Equal(prodID))
+			Expect(costs.Configs[2].IsStack).To(BeTrue())
+			Expect(costs.Configs[2].Estimate.MonthlyCost.String()).To(Equal("999.99"))
+			Expect(costs.Configs[3].ConfigID).To(Equal(devAppID))
+			Expect(costs.Unestimated()).To(HaveLen(1))
+			Expect(costs.Unestimated()[0].ConfigID).To(Equal(draftID))
+		})
+		It(`Returns an error for estimates in different currencies`, func() {
+			createConfig(&projectv1.ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype{
+				Name: core.StringPtr("network"),
+			}, estimate("1", "1"))
+			createConfig(&projectv1.ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype{
+				Name: core.StringPtr("cluster"),
+			}, &projectv1.ProjectConfigMetadataCostEstimate{Currency: core.StringPtr("EUR"), TotalMonthlyCost: core.StringPtr("2")})
+
+			_, err := projectService.GetProjectCosts(projectService.NewGetProjectCostsOptions(projectID))
+			Expect(errors.Is(err, projectv1.ErrCostCurrencyMismatch)).To(BeTrue())
+		})
+		It(`Returns the cost estimate of a prevalidation`, func() {
+			configID := createConfig(&projectv1.ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype{
+				Name: core.StringPtr("network"),
+			}, nil)
+			server.SetCostEstimate(configID, estimate("75.25", "50"))
+			started, _, err := projectService.CreatePrevalidate(projectService.NewCreatePrevalidateOptions(projectID, configID,
+				&projectv1.ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype{Name: core.StringPtr("network")}))
+			Expect(err).To(BeNil())
+			prevalidation, _, err := projectService.GetPrevalidate(projectService.NewGetPrevalidateOptions(projectID, configID, *started.ResultID))
+			Expect(err).To(BeNil())
+			parsed, err := projectv1.ParseCostEstimate(prevalidation.CostEstimate)
+			Expect(err).To(BeNil())
+			Expect(parsed.DiffMonthlyCost.String()).To(Equal("25.25"))
+		})
+	})
+})
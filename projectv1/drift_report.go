@@ -0,0 +1,358 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/project-go-sdk/common"
+	"github.com/go-openapi/strfmt"
+)
+
+// Constants associated with the ConfigDriftReport.Status property.
+// The drift status of the configuration.
+const (
+	ConfigDriftReport_Status_Drifted    = "drifted"
+	ConfigDriftReport_Status_Failed     = "failed"
+	ConfigDriftReport_Status_InSync     = "in_sync"
+	ConfigDriftReport_Status_NotChecked = "not_checked"
+)
+
+// Constants associated with the DriftedResource.Change property.
+// The change that the drift detection job plans for the resource to remove the drift.
+const (
+	DriftedResource_Change_Add     = "add"
+	DriftedResource_Change_Destroy = "destroy"
+	DriftedResource_Change_Failed  = "failed"
+	DriftedResource_Change_Update  = "update"
+)
+
+// GetDriftReportOptions : The GetDriftReport options.
+type GetDriftReportOptions struct {
+	// The unique IDs of the projects to report on. If no IDs are set, the report covers all the projects that have
+	// monitoring enabled.
+	ProjectIds []string `json:"project_ids,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewGetDriftReportOptions : Instantiate GetDriftReportOptions
+func (*ProjectV1) NewGetDriftReportOptions() *GetDriftReportOptions {
+	return &GetDriftReportOptions{}
+}
+
+// SetProjectIds : Allow user to set ProjectIds
+func (_options *GetDriftReportOptions) SetProjectIds(projectIds []string) *GetDriftReportOptions {
+	_options.ProjectIds = projectIds
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *GetDriftReportOptions) SetHeaders(param map[string]string) *GetDriftReportOptions {
+	options.Headers = param
+	return options
+}
+
+// DriftedResource : A resource that has drifted from the deployed configuration.
+type DriftedResource struct {
+	// The address of the resource, such as "ibm_is_vpc.vpc".
+	Address string `json:"address"`
+
+	// The change that the drift detection job plans for the resource to remove the drift.
+	Change string `json:"change"`
+}
+
+// ConfigDriftReport : The result of the last drift detection job of a configuration.
+type ConfigDriftReport struct {
+	// The unique project ID.
+	ProjectID string `json:"project_id"`
+
+	// The unique configuration ID.
+	ConfigID string `json:"config_id"`
+
+	// The name of the configuration.
+	ConfigName string `json:"config_name"`
+
+	// The drift status of the configuration.
+	Status string `json:"status"`
+
+	// The result of the last monitoring action.
+	Result string `json:"result,omitempty"`
+
+	// The time of the last drift detection event of the configuration. The service does not report when a monitoring
+	// job ran, so it is not set if the needs attention state of the configuration has no drift event.
+	CheckedAt *strfmt.DateTime `json:"checked_at,omitempty"`
+
+	// The ID of the last drift detection job.
+	JobID string `json:"job_id,omitempty"`
+
+	// The resources that have drifted.
+	Resources []DriftedResource `json:"resources,omitempty"`
+}
+
+// ProjectDriftReport : The drift of the configurations of a project.
+type ProjectDriftReport struct {
+	// The unique project ID.
+	ProjectID string `json:"project_id"`
+
+	// The name of the project.
+	ProjectName string `json:"project_name"`
+
+	// Whether the project runs a daily drift detection for its deployed configurations.
+	MonitoringEnabled bool `json:"monitoring_enabled"`
+
+	// The configurations, in the order in which the service lists them.
+	Configs []ConfigDriftReport `json:"configs"`
+}
+
+// DriftReport : The drift of the configurations of projects, from their last monitoring actions.
+type DriftReport struct {
+	// The time at which the report was generated.
+	GeneratedAt strfmt.DateTime `json:"generated_at"`
+
+	// The projects, in the order of the options or in the order in which the service lists them.
+	Projects []ProjectDriftReport `json:"projects"`
+}
+
+// Drifted returns the configurations that have drifted, across the projects of the report.
+func (report *DriftReport) Drifted() []ConfigDriftReport {
+	var drifted []ConfigDriftReport
+	for _, project := range report.Projects {
+		for _, config := range project.Configs {
+			if config.Status == ConfigDriftReport_Status_Drifted {
+				drifted = append(drifted, config)
+			}
+		}
+	}
+	return drifted
+}
+
+// HasDrift returns true if a configuration of the report has drifted.
+func (report *DriftReport) HasDrift() bool {
+	return len(report.Drifted()) > 0
+}
+
+// JSON renders the report as indented JSON.
+func (report *DriftReport) JSON() ([]byte, error) {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "drift-report-marshal-error", common.GetComponentInfo())
+	}
+	return data, nil
+}
+
+// Markdown renders the report as a Markdown document, with a section and a table of configurations per project.
+func (report *DriftReport) Markdown() string {
+	var builder strings.Builder
+	builder.WriteString("# Drift report\n\n")
+	fmt.Fprintf(&builder, "Generated at %s.\n", report.GeneratedAt.String())
+	for _, project := range report.Projects {
+		drifted := 0
+		for _, config := range project.Configs {
+			if config.Status == ConfigDriftReport_Status_Drifted {
+				drifted++
+			}
+		}
+		fmt.Fprintf(&builder, "\n## %s (%s)\n\n", markdownCell(project.ProjectName), project.ProjectID)
+		monitoring := "disabled"
+		if project.MonitoringEnabled {
+			monitoring = "enabled"
+		}
+		fmt.Fprintf(&builder, "Monitoring is %s. %d of %d configurations drifted.\n", monitoring, drifted, len(project.Configs))
+		if len(project.Configs) == 0 {
+			continue
+		}
+		builder.WriteString("\n| Configuration | Status | Last check | Job | Drifted resources |\n")
+		builder.WriteString("| --- | --- | --- | --- | --- |\n")
+		for _, config := range project.Configs {
+			checkedAt := ""
+			if config.CheckedAt != nil {
+				checkedAt = config.CheckedAt.String()
+			}
+			resources := make([]string, len(config.Resources))
+			for i, resource := range config.Resources {
+				resources[i] = fmt.Sprintf("%s (%s)", resource.Address, resource.Change)
+			}
+			fmt.Fprintf(&builder, "| %s | %s | %s | %s | %s |\n", markdownCell(config.ConfigName), config.Status,
+				checkedAt, markdownCell(config.JobID), markdownCell(strings.Join(resources, ", ")))
+		}
+	}
+	return builder.String()
+}
+
+// markdownCell escapes the characters of a value that would end a Markdown table cell.
+func markdownCell(value string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(value, "|", `\|`), "\n", " ")
+}
+
+// GetDriftReport : Report the drift of the configurations of projects
+// Walk the configurations of the projects and report, from the summary of their last monitoring action, which have
+// drifted, when the last drift was detected and which resources the drift detection job would change.
+func (project *ProjectV1) GetDriftReport(getDriftReportOptions *GetDriftReportOptions) (result *DriftReport, err error) {
+	result, err = project.GetDriftReportWithContext(context.Background(), getDriftReportOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetDriftReportWithContext is an alternate form of the GetDriftReport method which supports a Context parameter
+func (project *ProjectV1) GetDriftReportWithContext(ctx context.Context, getDriftReportOptions *GetDriftReportOptions) (result *DriftReport, err error) {
+	err = core.ValidateNotNil(getDriftReportOptions, "getDriftReportOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(getDriftReportOptions, "getDriftReportOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	headers := getDriftReportOptions.Headers
+
+	projectIds := getDriftReportOptions.ProjectIds
+	monitoredOnly := len(projectIds) == 0
+	if monitoredOnly {
+		var projectsPager *ProjectsPager
+		projectsPager, err = project.NewProjectsPager(&ListProjectsOptions{Headers: headers})
+		if err != nil {
+			return
+		}
+		var projects []ProjectSummary
+		projects, err = projectsPager.GetAllWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "list-projects-error")
+			return
+		}
+		for _, summary := range projects {
+			projectIds = append(projectIds, *summary.ID)
+		}
+	}
+
+	result = &DriftReport{
+		GeneratedAt: strfmt.DateTime(time.Now().UTC()),
+		Projects:    []ProjectDriftReport{},
+	}
+	for _, projectID := range projectIds {
+		var live *Project
+		live, _, err = project.GetProjectWithContext(ctx, &GetProjectOptions{ID: core.StringPtr(projectID), Headers: headers})
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "get-project-error")
+			return
+		}
+		projectReport := ProjectDriftReport{
+			ProjectID: projectID,
+			Configs:   []ConfigDriftReport{},
+		}
+		if live.Definition != nil {
+			projectReport.ProjectName = core.StringNilMapper(live.Definition.Name)
+			projectReport.MonitoringEnabled = live.Definition.MonitoringEnabled != nil && *live.Definition.MonitoringEnabled
+		}
+		if monitoredOnly && !projectReport.MonitoringEnabled {
+			continue
+		}
+
+		var configsPager *ConfigsPager
+		configsPager, err = project.NewConfigsPager(&ListConfigsOptions{ProjectID: core.StringPtr(projectID), Headers: headers})
+		if err != nil {
+			return
+		}
+		var summaries []ProjectConfigSummary
+		summaries, err = configsPager.GetAllWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "list-configs-error")
+			return
+		}
+		for _, summary := range summaries {
+			var config *ProjectConfig
+			config, _, err = project.GetConfigWithContext(ctx, &GetConfigOptions{ProjectID: core.StringPtr(projectID), ID: summary.ID, Headers: headers})
+			if err != nil {
+				err = core.RepurposeSDKProblem(err, "get-config-error")
+				return
+			}
+			projectReport.Configs = append(projectReport.Configs, configDriftReport(projectID, config))
+		}
+		result.Projects = append(result.Projects, projectReport)
+	}
+	return
+}
+
+// configDriftReport returns the drift of a configuration from the summary of its last monitoring action.
+func configDriftReport(projectID string, config *ProjectConfig) ConfigDriftReport {
+	report := ConfigDriftReport{
+		ProjectID: projectID,
+		ConfigID:  core.StringNilMapper(config.ID),
+		Status:    ConfigDriftReport_Status_NotChecked,
+	}
+	if definition := definitionResponse(config.Definition); definition != nil {
+		report.ConfigName = core.StringNilMapper(definition.Name)
+	}
+	for i := range config.NeedsAttentionState {
+		event := &config.NeedsAttentionState[i]
+		if event.Timestamp == nil || !strings.Contains(strings.ToLower(core.StringNilMapper(event.Event)), "drift") {
+			continue
+		}
+		if report.CheckedAt == nil || time.Time(*event.Timestamp).After(time.Time(*report.CheckedAt)) {
+			report.CheckedAt = event.Timestamp
+		}
+	}
+
+	monitoring := config.LastMonitoring
+	if monitoring == nil {
+		return report
+	}
+	report.Result = core.StringNilMapper(monitoring.Result)
+	if monitoring.Result != nil && *monitoring.Result == LastMonitoringActionWithSummary_Result_Failed {
+		report.Status = ConfigDriftReport_Status_Failed
+	}
+	if monitoring.DriftDetection == nil || monitoring.DriftDetection.Job == nil {
+		return report
+	}
+	job := monitoring.DriftDetection.Job
+	report.JobID = core.StringNilMapper(job.ID)
+	if report.Status == ConfigDriftReport_Status_Failed {
+		return report
+	}
+	report.Status = ConfigDriftReport_Status_InSync
+	if job.Summary == nil || job.Summary.PlanSummary == nil {
+		return report
+	}
+
+	plan := job.Summary.PlanSummary
+	for _, changed := range []struct {
+		change    string
+		count     *int64
+		resources []string
+	}{
+		{DriftedResource_Change_Add, plan.Add, plan.AddResources},
+		{DriftedResource_Change_Update, plan.Update, plan.UpdatedResources},
+		{DriftedResource_Change_Destroy, plan.Destroy, plan.DestroyResources},
+		{DriftedResource_Change_Failed, plan.Failed, plan.FailedResources},
+	} {
+		if changed.change != DriftedResource_Change_Failed && (len(changed.resources) > 0 || (changed.count != nil && *changed.count > 0)) {
+			report.Status = ConfigDriftReport_Status_Drifted
+		}
+		for _, address := range changed.resources {
+			report.Resources = append(report.Resources, DriftedResource{Address: address, Change: changed.change})
+		}
+	}
+	return report
+}
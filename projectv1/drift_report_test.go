@@ -0,0 +1,190 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1_test
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/project-go-sdk/projectv1"
+	"github.com/IBM/project-go-sdk/projectv1fake"
+	"github.com/go-openapi/strfmt"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`GetDriftReport`, func() {
+	var server *projectv1fake.Server
+	var projectService *projectv1.ProjectV1
+	var monitoredID string
+	var unmonitoredID string
+	var networkID string
+	var appID string
+	var dbID string
+	driftedAt := strfmt.DateTime(time.Date(2026, 10, 15, 6, 0, 0, 0, time.UTC))
+	failedAt := strfmt.DateTime(time.Date(2026, 10, 16, 6, 0, 0, 0, time.UTC))
+
+	createProject := func(name string, monitoringEnabled bool) string {
+		project, _, err := projectService.CreateProject(projectService.NewCreateProjectOptions(
+			&projectv1.ProjectPrototypeDefinition{
+				Name:              core.StringPtr(name),
+				MonitoringEnabled: core.BoolPtr(monitoringEnabled),
+			}, "us-south", "Default"))
+		Expect(err).To(BeNil())
+		return *project.ID
+	}
+	createConfig := func(projectID string, name string) string {
+		config, _, err := projectService.CreateConfig(projectService.NewCreateConfigOptions(projectID,
+			&projectv1.ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype{Name: core.StringPtr(name)}))
+		Expect(err).To(BeNil())
+		return *config.ID
+	}
+	monitoring := func(result string, jobID string, plan *projectv1.ActionJobPlanSummary) *projectv1.LastMonitoringActionWithSummary {
+		return &projectv1.LastMonitoringActionWithSummary{
+			Href:   core.StringPtr("https://schematics.cloud.ibm.com/v2/jobs/" + jobID),
+			Result: core.StringPtr(result),
+			DriftDetection: &projectv1.LastDriftDetectionJobSummary{
+				Job: &projectv1.ActionJobWithIdAndSummary{
+					ID:      core.StringPtr(jobID),
+					Summary: &projectv1.ActionJobSummary{PlanSummary: plan},
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		var err error
+		server = projectv1fake.NewServer()
+		projectService, err = server.NewProjectV1()
+		Expect(err).To(BeNil())
+
+		monitoredID = createProject("production", true)
+		unmonitoredID = createProject("sandbox", false)
+		networkID = createConfig(monitoredID, "network")
+		appID = createConfig(monitoredID, "app")
+		dbID = createConfig(monitoredID, "db")
+		createConfig(unmonitoredID, "scratch")
+
+		server.SetLastMonitoring(networkID, monitoring(projectv1.LastMonitoringActionWithSummary_Result_Succeeded, "job-1",
+			&projectv1.ActionJobPlanSummary{
+				Update:           core.Int64Ptr(1),
+				Destroy:          core.Int64Ptr(1),
+				UpdatedResources: []string{"ibm_is_vpc.vpc"},
+				DestroyResources: []string{"ibm_is_subnet.zone|1"},
+			}))
+		server.AddNeedsAttention(networkID,
+			projectv1.ProjectConfigNeedsAttentionState{
+				EventID:   core.StringPtr("event-1"),
+				Event:     core.StringPtr("project.config.drift_detected"),
+				Severity:  core.StringPtr("WARNING"),
+				Timestamp: &driftedAt,
+			},
+			projectv1.ProjectConfigNeedsAttentionState{
+				EventID:   core.StringPtr("event-2"),
+				Event:     core.StringPtr("project.config.validation_failed"),
+				Severity:  core.StringPtr("ERROR"),
+				Timestamp: &failedAt,
+			})
+		server.SetLastMonitoring(appID, monitoring(projectv1.LastMonitoringActionWithSummary_Result_Succeeded, "job-2",
+			&projectv1.ActionJobPlanSummary{Add: core.Int64Ptr(0), Update: core.Int64Ptr(0), Destroy: core.Int64Ptr(0)}))
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Reports the drift of the projects with monitoring enabled`, func() {
+		report, err := projectService.GetDriftReport(projectService.NewGetDriftReportOptions())
+		Expect(err).To(BeNil())
+		Expect(report.Projects).To(HaveLen(1))
+		Expect(report.Projects[0].ProjectID).To(Equal(monitoredID))
+		Expect(report.Projects[0].ProjectName).To(Equal("production"))
+		Expect(report.Projects[0].MonitoringEnabled).To(BeTrue())
+		Expect(report.Projects[0].Configs).To(Equal([]projectv1.ConfigDriftReport{
+			{
+				ProjectID:  monitoredID,
+				ConfigID:   networkID,
+				ConfigName: "network",
+				Status:     projectv1.ConfigDriftReport_Status_Drifted,
+				Result:     projectv1.LastMonitoringActionWithSummary_Result_Succeeded,
+				CheckedAt:  &driftedAt,
+				JobID:      "job-1",
+				Resources: []projectv1.DriftedResource{
+					{Address: "ibm_is_vpc.vpc", Change: projectv1.DriftedResource_Change_Update},
+					{Address: "ibm_is_subnet.zone|1", Change: projectv1.DriftedResource_Change_Destroy},
+				},
+			},
+			{
+				ProjectID:  monitoredID,
+				ConfigID:   appID,
+				ConfigName: "app",
+				Status:     projectv1.ConfigDriftReport_Status_InSync,
+				Result:     projectv1.LastMonitoringActionWithSummary_Result_Succeeded,
+				JobID:      "job-2",
+			},
+			{
+				ProjectID:  monitoredID,
+				ConfigID:   dbID,
+				ConfigName: "db",
+				Status:     projectv1.ConfigDriftReport_Status_NotChecked,
+			},
+		}))
+		Expect(report.HasDrift()).To(BeTrue())
+		Expect(report.Drifted()).To(HaveLen(1))
+		Expect(report.Drifted()[0].ConfigID).To(Equal(networkID))
+	})
+	It(`Reports the drift of the projects of the options`, func() {
+		server.SetLastMonitoring(networkID, monitoring(projectv1.LastMonitoringActionWithSummary_Result_Failed, "job-3", nil))
+
+		report, err := projectService.GetDriftReport(projectService.NewGetDriftReportOptions().
+			SetProjectIds([]string{unmonitoredID, monitoredID}))
+		Expect(err).To(BeNil())
+		Expect(report.Projects).To(HaveLen(2))
+		Expect(report.Projects[0].ProjectName).To(Equal("sandbox"))
+		Expect(report.Projects[0].MonitoringEnabled).To(BeFalse())
+		Expect(report.Projects[0].Configs).To(HaveLen(1))
+		Expect(report.Projects[0].Configs[0].Status).To(Equal(projectv1.ConfigDriftReport_Status_NotChecked))
+		Expect(report.Projects[1].Configs[0].Status).To(Equal(projectv1.ConfigDriftReport_Status_Failed))
+		Expect(report.Projects[1].Configs[0].JobID).To(Equal("job-3"))
+		Expect(report.HasDrift()).To(BeFalse())
+	})
+	It(`Renders the report as JSON and Markdown`, func() {
+		report, err := projectService.GetDriftReport(projectService.NewGetDriftReportOptions())
+		Expect(err).To(BeNil())
+
+		data, err := report.JSON()
+		Expect(err).To(BeNil())
+		var decoded projectv1.DriftReport
+		Expect(json.Unmarshal(data, &decoded)).To(Succeed())
+		Expect(decoded.Projects).To(Equal(report.Projects))
+
+		markdown := report.Markdown()
+		Expect(markdown).To(HavePrefix("# Drift report\n\nGenerated at "))
+		Expect(markdown).To(ContainSubstring("\n## production (" + monitoredID + ")\n\nMonitoring is enabled. 1 of 3 configurations drifted.\n"))
+		Expect(markdown).To(ContainSubstring("| Configuration | Status | Last check | Job | Drifted resources |\n"))
+		Expect(markdown).To(ContainSubstring("| network | drifted | 2026-10-15T06:00:00.000Z | job-1 | " +
+			`ibm_is_vpc.vpc (update), ibm_is_subnet.zone\|1 (destroy) |` + "\n"))
+		Expect(markdown).To(ContainSubstring("| db | not_checked |  |  |  |\n"))
+	})
+	It(`Returns an error for a project that does not exist`, func() {
+		_, err := projectService.GetDriftReport(projectService.NewGetDriftReportOptions().SetProjectIds([]string{"missing"}))
+		Expect(err).ToNot(BeNil())
+
+		_, err = projectService.GetDriftReport(nil)
+		Expect(err).ToNot(BeNil())
+	})
+})
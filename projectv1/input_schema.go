@@ -0,0 +1,267 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/project-go-sdk/common"
+)
+
+// Constants associated with the InputProblem.Code property.
+// The kind of problem.
+const (
+	InputProblem_Code_MissingRequired = "missing_required"
+	InputProblem_Code_TypeMismatch    = "type_mismatch"
+	InputProblem_Code_UnknownInput    = "unknown_input"
+)
+
+// inputReferencePrefix is the prefix of the input values that refer to other values, such as the outputs of another
+// configuration. The service resolves them, so their type is not known locally.
+const inputReferencePrefix = "ref:"
+
+// InputSchema : The input variables of a deployable architecture, used to validate the inputs of a configuration
+// before they are sent to the service.
+type InputSchema struct {
+	// The input variables.
+	Variables []StackDefinitionInputVariable `json:"variables"`
+}
+
+// NewInputSchema : Instantiate InputSchema
+// The variables can come from a stack definition, or be built with NewStackDefinitionInputVariable from the
+// variables of a catalog version.
+func NewInputSchema(variables []StackDefinitionInputVariable) *InputSchema {
+	return &InputSchema{
+		Variables: variables,
+	}
+}
+
+// Variable returns the input variable with a name.
+func (schema *InputSchema) Variable(name string) (variable StackDefinitionInputVariable, found bool) {
+	for _, variable = range schema.Variables {
+		if core.StringNilMapper(variable.Name) == name {
+			return variable, true
+		}
+	}
+	return StackDefinitionInputVariable{}, false
+}
+
+// InputProblem : A problem of an input value.
+type InputProblem struct {
+	// The name of the input.
+	Input string `json:"input"`
+
+	// The kind of problem.
+	Code string `json:"code"`
+
+	// The description of the problem.
+	Message string `json:"message"`
+}
+
+// InputValidationError : The error that ValidateInputs returns when inputs do not match their schema.
+type InputValidationError struct {
+	// The problems, sorted by input name.
+	Problems []InputProblem
+}
+
+// Error returns the messages of the problems.
+func (e *InputValidationError) Error() string {
+	messages := make([]string, len(e.Problems))
+	for i, problem := range e.Problems {
+		messages[i] = problem.Message
+	}
+	return "the inputs are not valid: " + strings.Join(messages, "; ")
+}
+
+// ValidateInputs : Check an inputs map against the schema
+// Report the required inputs that have no value and no default, the values whose type does not match the type of
+// their variable, and the inputs that the schema does not have. Values that are references, such as
+// "ref:../configs/network/outputs/vpc_id", are accepted for any type. If there are problems, the returned error is an
+// *InputValidationError.
+func (schema *InputSchema) ValidateInputs(inputs map[string]interface{}) error {
+	var problems []InputProblem
+	for _, variable := range schema.Variables {
+		name := core.StringNilMapper(variable.Name)
+		value, found := inputs[name]
+		if !found || value == nil {
+			if variable.Required != nil && *variable.Required && variable.Default == nil {
+				problems = append(problems, InputProblem{
+					Input:   name,
+					Code:    InputProblem_Code_MissingRequired,
+					Message: fmt.Sprintf("input '%s' is required", name),
+				})
+			}
+			continue
+		}
+		variableType := core.StringNilMapper(variable.Type)
+		if !inputHasType(value, variableType) {
+			problems = append(problems, InputProblem{
+				Input:   name,
+				Code:    InputProblem_Code_TypeMismatch,
+				Message: fmt.Sprintf("input '%s' must be of type %s, not %T", name, variableType, value),
+			})
+		}
+	}
+	for name := range inputs {
+		if _, found := schema.Variable(name); !found {
+			problems = append(problems, InputProblem{
+				Input:   name,
+				Code:    InputProblem_Code_UnknownInput,
+				Message: fmt.Sprintf("input '%s' is not an input of the deployable architecture", name),
+			})
+		}
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	sort.SliceStable(problems, func(i, j int) bool {
+		return problems[i].Input < problems[j].Input
+	})
+	return &InputValidationError{Problems: problems}
+}
+
+// inputHasType returns true if a value can be the value of a variable of a type. Types that are not known accept any
+// value.
+func inputHasType(value interface{}, variableType string) bool {
+	if reference, ok := value.(string); ok && strings.HasPrefix(reference, inputReferencePrefix) {
+		return true
+	}
+	if number, ok := value.(json.Number); ok {
+		if variableType == StackDefinitionInputVariable_Type_Int {
+			_, err := number.Int64()
+			return err == nil
+		}
+		if _, err := number.Float64(); err != nil {
+			return false
+		}
+		return variableType == StackDefinitionInputVariable_Type_Float || variableType == StackDefinitionInputVariable_Type_Number
+	}
+
+	kind := reflect.ValueOf(value).Kind()
+	switch variableType {
+	case StackDefinitionInputVariable_Type_String, StackDefinitionInputVariable_Type_Password:
+		return kind == reflect.String
+	case StackDefinitionInputVariable_Type_Boolean:
+		return kind == reflect.Bool
+	case StackDefinitionInputVariable_Type_Int:
+		switch kind {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return true
+		case reflect.Float32, reflect.Float64:
+			// Numbers decoded from JSON are float64 values.
+			number := reflect.ValueOf(value).Float()
+			return number == math.Trunc(number)
+		}
+		return false
+	case StackDefinitionInputVariable_Type_Float, StackDefinitionInputVariable_Type_Number:
+		switch kind {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			return true
+		}
+		return false
+	case StackDefinitionInputVariable_Type_Array:
+		return kind == reflect.Slice || kind == reflect.Array
+	case StackDefinitionInputVariable_Type_Object:
+		return kind == reflect.Map || kind == reflect.Struct || (kind == reflect.Ptr && reflect.ValueOf(value).Elem().Kind() == reflect.Struct)
+	}
+	return true
+}
+
+// GetStackInputSchemaOptions : The GetStackInputSchema options.
+type GetStackInputSchemaOptions struct {
+	// The unique project ID.
+	ProjectID *string `json:"project_id" validate:"required,ne="`
+
+	// The unique configuration ID of the stack.
+	ID *string `json:"id" validate:"required,ne="`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewGetStackInputSchemaOptions : Instantiate GetStackInputSchemaOptions
+func (*ProjectV1) NewGetStackInputSchemaOptions(projectID string, id string) *GetStackInputSchemaOptions {
+	return &GetStackInputSchemaOptions{
+		ProjectID: core.StringPtr(projectID),
+		ID:        core.StringPtr(id),
+	}
+}
+
+// SetProjectID : Allow user to set ProjectID
+func (_options *GetStackInputSchemaOptions) SetProjectID(projectID string) *GetStackInputSchemaOptions {
+	_options.ProjectID = core.StringPtr(projectID)
+	return _options
+}
+
+// SetID : Allow user to set ID
+func (_options *GetStackInputSchemaOptions) SetID(id string) *GetStackInputSchemaOptions {
+	_options.ID = core.StringPtr(id)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *GetStackInputSchemaOptions) SetHeaders(param map[string]string) *GetStackInputSchemaOptions {
+	options.Headers = param
+	return options
+}
+
+// GetStackInputSchema : Get the input schema of a stack
+// Get the stack definition of a stack configuration and return the schema of its inputs.
+func (project *ProjectV1) GetStackInputSchema(getStackInputSchemaOptions *GetStackInputSchemaOptions) (result *InputSchema, err error) {
+	result, err = project.GetStackInputSchemaWithContext(context.Background(), getStackInputSchemaOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetStackInputSchemaWithContext is an alternate form of the GetStackInputSchema method which supports a Context parameter
+func (project *ProjectV1) GetStackInputSchemaWithContext(ctx context.Context, getStackInputSchemaOptions *GetStackInputSchemaOptions) (result *InputSchema, err error) {
+	err = core.ValidateNotNil(getStackInputSchemaOptions, "getStackInputSchemaOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(getStackInputSchemaOptions, "getStackInputSchemaOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	stackDefinition, _, err := project.GetStackDefinitionWithContext(ctx, &GetStackDefinitionOptions{
+		ProjectID: getStackInputSchemaOptions.ProjectID,
+		ID:        getStackInputSchemaOptions.ID,
+		Headers:   getStackInputSchemaOptions.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-stack-definition-error")
+		return
+	}
+	result = NewInputSchema(nil)
+	if stackDefinition.StackDefinition != nil {
+		result.Variables = stackDefinition.StackDefinition.Inputs
+	}
+	return
+}
@@ -0,0 +1,121 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/project-go-sdk/projectv1"
+	"github.com/IBM/project-go-sdk/projectv1fake"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`InputSchema`, func() {
+	variable := func(name string, variableType string, required bool, defaultValue interface{}) projectv1.StackDefinitionInputVariable {
+		return projectv1.StackDefinitionInputVariable{
+			Name:     core.StringPtr(name),
+			Type:     core.StringPtr(variableType),
+			Required: core.BoolPtr(required),
+			Default:  defaultValue,
+		}
+	}
+	schema := projectv1.NewInputSchema([]projectv1.StackDefinitionInputVariable{
+		variable("region", projectv1.StackDefinitionInputVariable_Type_String, true, nil),
+		variable("prefix", projectv1.StackDefinitionInputVariable_Type_String, true, "dev"),
+		variable("api_key", projectv1.StackDefinitionInputVariable_Type_Password, false, nil),
+		variable("enabled", projectv1.StackDefinitionInputVariable_Type_Boolean, false, true),
+		variable("count", projectv1.StackDefinitionInputVariable_Type_Int, false, 1),
+		variable("ratio", projectv1.StackDefinitionInputVariable_Type_Float, false, nil),
+		variable("zones", projectv1.StackDefinitionInputVariable_Type_Array, false, nil),
+		variable("tags", projectv1.StackDefinitionInputVariable_Type_Object, false, nil),
+	})
+
+	It(`Accepts valid inputs`, func() {
+		Expect(schema.ValidateInputs(map[string]interface{}{
+			"region":  "us-south",
+			"api_key": "ref:../environments/dev/authorizations/api_key",
+			"enabled": false,
+			"count":   float64(3),
+			"ratio":   2,
+			"zones":   []string{"1", "2"},
+			"tags":    map[string]interface{}{"team": "network"},
+		})).To(Succeed())
+
+		var inputs map[string]interface{}
+		decoder := json.NewDecoder(bytes.NewReader([]byte(`{"region": "us-south", "count": 3, "ratio": 0.5}`)))
+		decoder.UseNumber()
+		Expect(decoder.Decode(&inputs)).To(Succeed())
+		Expect(schema.ValidateInputs(inputs)).To(Succeed())
+	})
+	It(`Reports missing required inputs, type mismatches and unknown inputs`, func() {
+		err := schema.ValidateInputs(map[string]interface{}{
+			"count":   1.5,
+			"enabled": "yes",
+			"regoin":  "us-south",
+			"zones":   "1,2",
+		})
+		var validationErr *projectv1.InputValidationError
+		Expect(errors.As(err, &validationErr)).To(BeTrue())
+		Expect(validationErr.Problems).To(HaveLen(5))
+		Expect(validationErr.Problems[0]).To(Equal(projectv1.InputProblem{
+			Input:   "count",
+			Code:    projectv1.InputProblem_Code_TypeMismatch,
+			Message: "input 'count' must be of type int, not float64",
+		}))
+		Expect(validationErr.Problems[1].Input).To(Equal("enabled"))
+		Expect(validationErr.Problems[2].Input).To(Equal("region"))
+		Expect(validationErr.Problems[2].Code).To(Equal(projectv1.InputProblem_Code_MissingRequired))
+		Expect(validationErr.Problems[3].Input).To(Equal("regoin"))
+		Expect(validationErr.Problems[3].Code).To(Equal(projectv1.InputProblem_Code_UnknownInput))
+		Expect(validationErr.Problems[4].Input).To(Equal("zones"))
+		Expect(err.Error()).To(ContainSubstring("input 'region' is required"))
+	})
+	It(`Gets the input schema of a stack`, func() {
+		server := projectv1fake.NewServer()
+		defer server.Close()
+		projectService, err := server.NewProjectV1()
+		Expect(err).To(BeNil())
+
+		project, _, err := projectService.CreateProject(projectService.NewCreateProjectOptions(
+			&projectv1.ProjectPrototypeDefinition{Name: core.StringPtr("my-project")}, "us-south", "Default"))
+		Expect(err).To(BeNil())
+		stack, _, err := projectService.CreateConfig(projectService.NewCreateConfigOptions(*project.ID,
+			&projectv1.ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype{
+				Name:    core.StringPtr("stack"),
+				Members: []projectv1.StackMember{{Name: core.StringPtr("network"), ConfigID: core.StringPtr("network")}},
+			}))
+		Expect(err).To(BeNil())
+		_, _, err = projectService.CreateStackDefinition(projectService.NewCreateStackDefinitionOptions(*project.ID, *stack.ID,
+			&projectv1.StackDefinitionBlockPrototype{Inputs: schema.Variables}))
+		Expect(err).To(BeNil())
+
+		stackSchema, err := projectService.GetStackInputSchema(projectService.NewGetStackInputSchemaOptions(*project.ID, *stack.ID))
+		Expect(err).To(BeNil())
+		Expect(stackSchema.Variables).To(HaveLen(len(schema.Variables)))
+		region, found := stackSchema.Variable("region")
+		Expect(found).To(BeTrue())
+		Expect(*region.Required).To(BeTrue())
+		Expect(stackSchema.ValidateInputs(map[string]interface{}{"region": 1})).ToNot(Succeed())
+
+		_, err = projectService.GetStackInputSchema(projectService.NewGetStackInputSchemaOptions(*project.ID, "missing"))
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,345 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/project-go-sdk/common"
+	"gopkg.in/yaml.v3"
+)
+
+// Manifest : The desired state of a project, its environments and its configurations.
+// The definitions use the same properties as the requests that create them, so a manifest reads like the bodies of
+// the CreateProject, CreateProjectEnvironment and CreateConfig requests. Environments and configurations are
+// identified by the names in their definitions, which must be unique within the manifest.
+type Manifest struct {
+	// The project.
+	Project *ManifestProject `json:"project" validate:"required"`
+
+	// The environments of the project.
+	Environments []ManifestEnvironment `json:"environments,omitempty"`
+
+	// The configurations of the project.
+	Configs []ManifestConfig `json:"configs,omitempty"`
+}
+
+// ManifestProject : The desired state of a project.
+type ManifestProject struct {
+	// The unique project ID. If it is not set, the project is identified by the name in its definition.
+	ID *string `json:"id,omitempty"`
+
+	// The location where the project is created. It is only used to create the project.
+	Location *string `json:"location,omitempty"`
+
+	// The resource group name where the project is created. It is only used to create the project.
+	ResourceGroup *string `json:"resource_group,omitempty"`
+
+	// The definition of the project.
+	Definition *ProjectPrototypeDefinition `json:"definition" validate:"required"`
+}
+
+// ManifestEnvironment : The desired state of an environment.
+type ManifestEnvironment struct {
+	// The definition of the environment.
+	Definition *EnvironmentDefinitionRequiredProperties `json:"definition" validate:"required"`
+}
+
+// ManifestConfig : The desired state of a configuration.
+type ManifestConfig struct {
+	// The name of the manifest environment of the configuration. When it is set, the ID of that environment is used
+	// as the environment ID of the definition, so that a manifest does not depend on the IDs of an account.
+	Environment *string `json:"environment,omitempty"`
+
+	// The definition of the configuration.
+	Definition ProjectConfigDefinitionPrototypeIntf `json:"definition" validate:"required"`
+
+	// A Schematics workspace to use for deploying this deployable architecture. It is only used to create the
+	// configuration.
+	Schematics *SchematicsWorkspace `json:"schematics,omitempty"`
+
+	// The stack definition of a stack configuration. It is only used to create the configuration.
+	StackDefinition *StackDefinitionBlockPrototype `json:"stack_definition,omitempty"`
+}
+
+// UnmarshalManifest unmarshals an instance of Manifest from the specified map of raw messages.
+func UnmarshalManifest(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(Manifest)
+	err = core.UnmarshalModel(m, "project", &obj.Project, UnmarshalManifestProject)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "project-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalModel(m, "environments", &obj.Environments, UnmarshalManifestEnvironment)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "environments-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalModel(m, "configs", &obj.Configs, UnmarshalManifestConfig)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "configs-error", common.GetComponentInfo())
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
+
+// UnmarshalManifestProject unmarshals an instance of ManifestProject from the specified map of raw messages.
+func UnmarshalManifestProject(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(ManifestProject)
+	err = core.UnmarshalPrimitive(m, "id", &obj.ID)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "id-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "location", &obj.Location)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "location-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "resource_group", &obj.ResourceGroup)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "resource_group-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalModel(m, "definition", &obj.Definition, UnmarshalProjectPrototypeDefinition)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "definition-error", common.GetComponentInfo())
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
+
+// UnmarshalManifestEnvironment unmarshals an instance of ManifestEnvironment from the specified map of raw messages.
+func UnmarshalManifestEnvironment(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(ManifestEnvironment)
+	err = core.UnmarshalModel(m, "definition", &obj.Definition, UnmarshalEnvironmentDefinitionRequiredProperties)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "definition-error", common.GetComponentInfo())
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
+
+// UnmarshalManifestConfig unmarshals an instance of ManifestConfig from the specified map of raw messages.
+func UnmarshalManifestConfig(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(ManifestConfig)
+	err = core.UnmarshalPrimitive(m, "environment", &obj.Environment)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "environment-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalModel(m, "definition", &obj.Definition, UnmarshalProjectConfigDefinitionPrototype)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "definition-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalModel(m, "schematics", &obj.Schematics, UnmarshalSchematicsWorkspace)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "schematics-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalModel(m, "stack_definition", &obj.StackDefinition, UnmarshalStackDefinitionBlockPrototype)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "stack_definition-error", common.GetComponentInfo())
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
+
+// ParseManifest : Parse a YAML or JSON manifest
+// The manifest is checked with Validate.
+func ParseManifest(data []byte) (manifest *Manifest, err error) {
+	var document interface{}
+	err = yaml.Unmarshal(data, &document)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "manifest-syntax-error", common.GetComponentInfo())
+		return
+	}
+	document, err = jsonCompatible(document)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "manifest-syntax-error", common.GetComponentInfo())
+		return
+	}
+	encoded, err := json.Marshal(document)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "manifest-syntax-error", common.GetComponentInfo())
+		return
+	}
+	var raw map[string]json.RawMessage
+	err = json.Unmarshal(encoded, &raw)
+	if err != nil || raw == nil {
+		err = core.SDKErrorf(nil, "a manifest must be an object", "manifest-syntax-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalModel(raw, "", &manifest, UnmarshalManifest)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "manifest-unmarshal-error", common.GetComponentInfo())
+		return
+	}
+	err = manifest.Validate()
+	if err != nil {
+		manifest = nil
+	}
+	return
+}
+
+// jsonCompatible converts the maps that YAML decodes with non-string keys into maps with string keys.
+func jsonCompatible(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			converted, err := jsonCompatible(item)
+			if err != nil {
+				return nil, err
+			}
+			v[key] = converted
+		}
+	case map[interface{}]interface{}:
+		converted := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			name, ok := key.(string)
+			if !ok {
+				return nil, fmt.Errorf("key %v is not a string", key)
+			}
+			convertedItem, err := jsonCompatible(item)
+			if err != nil {
+				return nil, err
+			}
+			converted[name] = convertedItem
+		}
+		return converted, nil
+	case []interface{}:
+		for i, item := range v {
+			converted, err := jsonCompatible(item)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = converted
+		}
+	}
+	return value, nil
+}
+
+// Validate : Check that a manifest has the required properties and that its names are unique
+// The names of the project, its environments and its configurations are required, environment and configuration
+// names must be unique, and the environment of a configuration must be one of the manifest environments.
+func (manifest *Manifest) Validate() error {
+	err := core.ValidateStruct(manifest, "manifest")
+	if err != nil {
+		return core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+	}
+	if core.StringNilMapper(manifest.Project.Definition.Name) == "" {
+		return core.SDKErrorf(nil, "the project definition must have a name", "manifest-validation-error", common.GetComponentInfo())
+	}
+
+	environments := make(map[string]bool)
+	for i, environment := range manifest.Environments {
+		if environment.Definition == nil || core.StringNilMapper(environment.Definition.Name) == "" {
+			return core.SDKErrorf(nil, fmt.Sprintf("environment %d must have a definition with a name", i), "manifest-validation-error", common.GetComponentInfo())
+		}
+		name := *environment.Definition.Name
+		if environments[name] {
+			return core.SDKErrorf(nil, fmt.Sprintf("environment '%s' is defined more than once", name), "manifest-validation-error", common.GetComponentInfo())
+		}
+		environments[name] = true
+	}
+
+	configs := make(map[string]bool)
+	for i, config := range manifest.Configs {
+		name := prototypeName(config.Definition)
+		if name == "" {
+			return core.SDKErrorf(nil, fmt.Sprintf("configuration %d must have a definition with a name", i), "manifest-validation-error", common.GetComponentInfo())
+		}
+		if configs[name] {
+			return core.SDKErrorf(nil, fmt.Sprintf("configuration '%s' is defined more than once", name), "manifest-validation-error", common.GetComponentInfo())
+		}
+		configs[name] = true
+		if config.Environment != nil && !environments[*config.Environment] {
+			return core.SDKErrorf(nil, fmt.Sprintf("configuration '%s' uses environment '%s', which is not in the manifest", name, *config.Environment),
+				"manifest-validation-error", common.GetComponentInfo())
+		}
+	}
+	return nil
+}
+
+// JSON : Encode a manifest as indented JSON
+func (manifest *Manifest) JSON() ([]byte, error) {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "manifest-marshal-error", common.GetComponentInfo())
+	}
+	return append(data, '\n'), nil
+}
+
+// YAML : Encode a manifest as YAML
+func (manifest *Manifest) YAML() ([]byte, error) {
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "manifest-marshal-error", common.GetComponentInfo())
+	}
+	// Decoding the JSON into a yaml.Node keeps the order of the properties.
+	var document yaml.Node
+	err = yaml.Unmarshal(data, &document)
+	if err == nil {
+		setBlockStyle(&document)
+		data, err = yaml.Marshal(&document)
+	}
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "manifest-marshal-error", common.GetComponentInfo())
+	}
+	return data, nil
+}
+
+// setBlockStyle clears the flow style that YAML nodes decoded from JSON have, so they are encoded in block style.
+func setBlockStyle(node *yaml.Node) {
+	node.Style &^= yaml.FlowStyle
+	if node.Kind == yaml.ScalarNode && node.Tag == "!!str" {
+		node.Style &^= yaml.DoubleQuotedStyle
+	}
+	for _, child := range node.Content {
+		setBlockStyle(child)
+	}
+}
+
+// definitionProperties returns the JSON properties of a definition.
+func definitionProperties(definition interface{}) map[string]interface{} {
+	properties := make(map[string]interface{})
+	data, err := json.Marshal(definition)
+	if err == nil {
+		_ = json.Unmarshal(data, &properties)
+	}
+	if properties == nil {
+		properties = make(map[string]interface{})
+	}
+	return properties
+}
+
+// prototypeName returns the name of a configuration definition, or an empty string if it has none.
+func prototypeName(definition ProjectConfigDefinitionPrototypeIntf) string {
+	if definition == nil {
+		return ""
+	}
+	name, _ := definitionProperties(definition)["name"].(string)
+	return name
+}
@@ -0,0 +1,700 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/project-go-sdk/common"
+)
+
+// Constants associated with the ManifestChange.Action property.
+// The operation that applies the change.
+const (
+	ManifestChange_Action_Create = "create"
+	ManifestChange_Action_Delete = "delete"
+	ManifestChange_Action_Update = "update"
+)
+
+// Constants associated with the ManifestChange.Kind property.
+// The kind of resource that the change applies to.
+const (
+	ManifestChange_Kind_Config      = "config"
+	ManifestChange_Kind_Environment = "environment"
+	ManifestChange_Kind_Project     = "project"
+)
+
+// ApplyManifestOptions : The ApplyManifest options.
+type ApplyManifestOptions struct {
+	// The desired state of the project.
+	Manifest *Manifest `json:"manifest" validate:"required"`
+
+	// Whether to only compute the plan, without changing the project.
+	DryRun *bool `json:"dry_run,omitempty"`
+
+	// Whether to delete the environments and configurations of the project that are not in the manifest.
+	Prune *bool `json:"prune,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewApplyManifestOptions : Instantiate ApplyManifestOptions
+func (*ProjectV1) NewApplyManifestOptions(manifest *Manifest) *ApplyManifestOptions {
+	return &ApplyManifestOptions{
+		Manifest: manifest,
+	}
+}
+
+// SetManifest : Allow user to set Manifest
+func (_options *ApplyManifestOptions) SetManifest(manifest *Manifest) *ApplyManifestOptions {
+	_options.Manifest = manifest
+	return _options
+}
+
+// SetDryRun : Allow user to set DryRun
+func (_options *ApplyManifestOptions) SetDryRun(dryRun bool) *ApplyManifestOptions {
+	_options.DryRun = core.BoolPtr(dryRun)
+	return _options
+}
+
+// SetPrune : Allow user to set Prune
+func (_options *ApplyManifestOptions) SetPrune(prune bool) *ApplyManifestOptions {
+	_options.Prune = core.BoolPtr(prune)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *ApplyManifestOptions) SetHeaders(param map[string]string) *ApplyManifestOptions {
+	options.Headers = param
+	return options
+}
+
+// ManifestChange : A change that brings a project closer to its manifest.
+type ManifestChange struct {
+	// The operation that applies the change.
+	Action string `json:"action"`
+
+	// The kind of resource that the change applies to.
+	Kind string `json:"kind"`
+
+	// The name of the resource.
+	Name string `json:"name"`
+
+	// The ID of the resource, once it exists.
+	ID string `json:"id,omitempty"`
+
+	// The definition properties that an update changes.
+	Properties []string `json:"properties,omitempty"`
+
+	// Whether the change was applied.
+	Applied bool `json:"applied"`
+
+	apply func(ctx context.Context) (id string, err error)
+}
+
+// String returns a line that describes the change, prefixed with "+" for a creation, "~" for an update and "-" for a
+// deletion.
+func (change *ManifestChange) String() string {
+	switch change.Action {
+	case ManifestChange_Action_Create:
+		return fmt.Sprintf("+ %s %s", change.Kind, change.Name)
+	case ManifestChange_Action_Delete:
+		return fmt.Sprintf("- %s %s", change.Kind, change.Name)
+	}
+	return fmt.Sprintf("~ %s %s (%s)", change.Kind, change.Name, strings.Join(change.Properties, ", "))
+}
+
+// ManifestPlan : The changes that ApplyManifest makes, or would make in a dry run, in the order they are applied.
+type ManifestPlan struct {
+	// The unique project ID, once the project exists.
+	ProjectID string `json:"project_id,omitempty"`
+
+	// Whether the plan was only computed.
+	DryRun bool `json:"dry_run"`
+
+	// The changes.
+	Changes []ManifestChange `json:"changes"`
+}
+
+// HasChanges returns true if the project differs from its manifest.
+func (plan *ManifestPlan) HasChanges() bool {
+	return len(plan.Changes) > 0
+}
+
+// String returns the description of the changes, one per line.
+func (plan *ManifestPlan) String() string {
+	if !plan.HasChanges() {
+		return "No changes.\n"
+	}
+	var builder strings.Builder
+	for i := range plan.Changes {
+		builder.WriteString(plan.Changes[i].String())
+		builder.WriteString("\n")
+	}
+	return builder.String()
+}
+
+// ApplyManifest : Reconcile a project with a manifest
+// Compare the project, environments and configurations of the manifest with the live state, and make the calls that
+// bring the live state to the manifest: create the project and the environments and configurations that do not exist,
+// and update the definition properties that differ. Only the properties and the nested keys that the manifest sets are
+// compared, so the keys that the service adds, such as defaults, do not make a change; the properties and keys that
+// the manifest does not set are left as they are. The secrets that the service masks in its responses, such as API
+// keys and secure inputs, cannot be compared: a change of a secret alone is not detected, so update it with the
+// generated methods. With the Prune option, the environments and configurations that are not in the manifest are
+// deleted. With the DryRun option, the plan is returned without making any change; print it with ManifestPlan.String.
+// When an error is returned, the plan reports the changes that were applied.
+func (project *ProjectV1) ApplyManifest(applyManifestOptions *ApplyManifestOptions) (result *ManifestPlan, err error) {
+	result, err = project.ApplyManifestWithContext(context.Background(), applyManifestOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ApplyManifestWithContext is an alternate form of the ApplyManifest method which supports a Context parameter
+func (project *ProjectV1) ApplyManifestWithContext(ctx context.Context, applyManifestOptions *ApplyManifestOptions) (result *ManifestPlan, err error) {
+	return project.applyManifest(ctx, project, applyManifestOptions)
+}
+
+// applyManifest applies a manifest and deletes the configurations that it prunes through the DeleteConfigWithContext
+// method of actions.
+func (project *ProjectV1) applyManifest(ctx context.Context, actions configActions, applyManifestOptions *ApplyManifestOptions) (result *ManifestPlan, err error) {
+	err = core.ValidateNotNil(applyManifestOptions, "applyManifestOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(applyManifestOptions, "applyManifestOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	err = applyManifestOptions.Manifest.Validate()
+	if err != nil {
+		return
+	}
+	if paths := applyManifestOptions.Manifest.secretPlaceholders(); len(paths) > 0 {
+		err = core.SDKErrorf(nil, fmt.Sprintf("the manifest has secret placeholders to replace: %s", strings.Join(paths, ", ")),
+			"manifest-secret-placeholder", common.GetComponentInfo())
+		return
+	}
+
+	planner := &manifestPlanner{
+		service:        project,
+		actions:        actions,
+		manifest:       applyManifestOptions.Manifest,
+		headers:        applyManifestOptions.Headers,
+		prune:          applyManifestOptions.Prune != nil && *applyManifestOptions.Prune,
+		environmentIDs: make(map[string]string),
+		configIDs:      make(map[string]string),
+		plan:           &ManifestPlan{DryRun: applyManifestOptions.DryRun != nil && *applyManifestOptions.DryRun},
+	}
+	err = planner.planProject(ctx)
+	if err == nil {
+		err = planner.planEnvironments(ctx)
+	}
+	if err == nil {
+		err = planner.planConfigs(ctx)
+	}
+	planner.plan.Changes = append(planner.plan.Changes, planner.environmentDeletions...)
+	result = planner.plan
+	if err != nil || result.DryRun {
+		return
+	}
+
+	for i := range result.Changes {
+		change := &result.Changes[i]
+		id, applyErr := change.apply(ctx)
+		if id != "" {
+			change.ID = id
+		}
+		if applyErr != nil {
+			err = core.RepurposeSDKProblem(applyErr, fmt.Sprintf("%s-%s-error", change.Action, change.Kind))
+			return
+		}
+		change.Applied = true
+	}
+	return
+}
+
+// manifestPlanner computes the changes that apply a manifest. The apply functions of the changes read the project
+// and environment IDs when they run, so that they use the IDs of the resources created by the earlier changes.
+type manifestPlanner struct {
+	service        *ProjectV1
+	actions        configActions
+	manifest       *Manifest
+	headers        map[string]string
+	prune          bool
+	plan           *ManifestPlan
+	environmentIDs map[string]string
+	configIDs      map[string]string
+
+	// The deletions of the environments that are not in the manifest, planned after the configuration changes.
+	environmentDeletions []ManifestChange
+}
+
+func (planner *manifestPlanner) addChange(change ManifestChange) {
+	planner.plan.Changes = append(planner.plan.Changes, change)
+}
+
+// findProject returns the live project of the manifest, or nil if it does not exist.
+func (planner *manifestPlanner) findProject(ctx context.Context) (*Project, error) {
+	desired := planner.manifest.Project
+	if desired.ID != nil {
+		live, _, err := planner.service.GetProjectWithContext(ctx, &GetProjectOptions{ID: desired.ID, Headers: planner.headers})
+		if err != nil {
+			return nil, core.RepurposeSDKProblem(err, "get-project-error")
+		}
+		return live, nil
+	}
+
+	pager, err := planner.service.NewProjectsPager(&ListProjectsOptions{Headers: planner.headers})
+	if err != nil {
+		return nil, err
+	}
+	projects, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "list-projects-error")
+	}
+	var found *ProjectSummary
+	for i := range projects {
+		if projects[i].Definition != nil && core.StringNilMapper(projects[i].Definition.Name) == *desired.Definition.Name {
+			if found != nil {
+				return nil, core.SDKErrorf(nil, fmt.Sprintf("more than one project is named '%s'; set the project ID in the manifest", *desired.Definition.Name),
+					"ambiguous-project-name", common.GetComponentInfo())
+			}
+			found = &projects[i]
+		}
+	}
+	if found == nil {
+		return nil, nil
+	}
+	live, _, err := planner.service.GetProjectWithContext(ctx, &GetProjectOptions{ID: found.ID, Headers: planner.headers})
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "get-project-error")
+	}
+	return live, nil
+}
+
+func (planner *manifestPlanner) planProject(ctx context.Context) error {
+	desired := planner.manifest.Project
+	name := *desired.Definition.Name
+	live, err := planner.findProject(ctx)
+	if err != nil {
+		return err
+	}
+
+	if live == nil {
+		if core.StringNilMapper(desired.Location) == "" || core.StringNilMapper(desired.ResourceGroup) == "" {
+			return core.SDKErrorf(nil, fmt.Sprintf("project '%s' does not exist, and the manifest has no location and resource group to create it", name),
+				"missing-project-location", common.GetComponentInfo())
+		}
+		planner.addChange(ManifestChange{
+			Action: ManifestChange_Action_Create,
+			Kind:   ManifestChange_Kind_Project,
+			Name:   name,
+			apply: func(ctx context.Context) (string, error) {
+				created, _, err := planner.service.CreateProjectWithContext(ctx, &CreateProjectOptions{
+					Definition:    desired.Definition,
+					Location:      desired.Location,
+					ResourceGroup: desired.ResourceGroup,
+					Headers:       planner.headers,
+				})
+				if err != nil {
+					return "", err
+				}
+				planner.plan.ProjectID = *created.ID
+				return *created.ID, nil
+			},
+		})
+		return nil
+	}
+
+	planner.plan.ProjectID = *live.ID
+	desiredProperties := definitionProperties(desired.Definition)
+	changed := changedProperties(desiredProperties, definitionProperties(live.Definition))
+	if len(changed) > 0 {
+		planner.addChange(ManifestChange{
+			Action:     ManifestChange_Action_Update,
+			Kind:       ManifestChange_Kind_Project,
+			Name:       name,
+			ID:         *live.ID,
+			Properties: changed,
+			apply: func(ctx context.Context) (string, error) {
+				var patch *ProjectDefinitionPatch
+				if err := unmarshalProperties(desiredProperties, changed, &patch, UnmarshalProjectDefinitionPatch); err != nil {
+					return "", err
+				}
+				_, _, err := planner.service.UpdateProjectWithContext(ctx, &UpdateProjectOptions{
+					ID:         live.ID,
+					Definition: patch,
+					Headers:    planner.headers,
+				})
+				return "", err
+			},
+		})
+	}
+	return nil
+}
+
+func (planner *manifestPlanner) planEnvironments(ctx context.Context) error {
+	live := make(map[string]Environment)
+	var liveNames []string
+	if planner.plan.ProjectID != "" {
+		pager, err := planner.service.NewProjectEnvironmentsPager(&ListProjectEnvironmentsOptions{
+			ProjectID: core.StringPtr(planner.plan.ProjectID),
+			Headers:   planner.headers,
+		})
+		if err != nil {
+			return err
+		}
+		environments, err := pager.GetAllWithContext(ctx)
+		if err != nil {
+			return core.RepurposeSDKProblem(err, "list-environments-error")
+		}
+		for _, environment := range environments {
+			if environment.Definition == nil || environment.Definition.Name == nil {
+				continue
+			}
+			live[*environment.Definition.Name] = environment
+			liveNames = append(liveNames, *environment.Definition.Name)
+			planner.environmentIDs[*environment.Definition.Name] = *environment.ID
+		}
+	}
+
+	wanted := make(map[string]bool)
+	for _, environment := range planner.manifest.Environments {
+		desired := environment.Definition
+		name := *desired.Name
+		wanted[name] = true
+		liveEnvironment, exists := live[name]
+		if !exists {
+			planner.addChange(ManifestChange{
+				Action: ManifestChange_Action_Create,
+				Kind:   ManifestChange_Kind_Environment,
+				Name:   name,
+				apply: func(ctx context.Context) (string, error) {
+					created, _, err := planner.service.CreateProjectEnvironmentWithContext(ctx, &CreateProjectEnvironmentOptions{
+						ProjectID:  core.StringPtr(planner.plan.ProjectID),
+						Definition: desired,
+						Headers:    planner.headers,
+					})
+					if err != nil {
+						return "", err
+					}
+					planner.environmentIDs[name] = *created.ID
+					return *created.ID, nil
+				},
+			})
+			continue
+		}
+
+		desiredProperties := definitionProperties(desired)
+		changed := changedProperties(desiredProperties, definitionProperties(liveEnvironment.Definition))
+		if len(changed) > 0 {
+			planner.addChange(ManifestChange{
+				Action:     ManifestChange_Action_Update,
+				Kind:       ManifestChange_Kind_Environment,
+				Name:       name,
+				ID:         *liveEnvironment.ID,
+				Properties: changed,
+				apply: func(ctx context.Context) (string, error) {
+					var patch *EnvironmentDefinitionPropertiesPatch
+					if err := unmarshalProperties(desiredProperties, changed, &patch, UnmarshalEnvironmentDefinitionPropertiesPatch); err != nil {
+						return "", err
+					}
+					_, _, err := planner.service.UpdateProjectEnvironmentWithContext(ctx, &UpdateProjectEnvironmentOptions{
+						ProjectID:  core.StringPtr(planner.plan.ProjectID),
+						ID:         liveEnvironment.ID,
+						Definition: patch,
+						Headers:    planner.headers,
+					})
+					return "", err
+				},
+			})
+		}
+	}
+
+	// Environments are deleted after the configurations, which may still use them.
+	if planner.prune {
+		for _, name := range liveNames {
+			if wanted[name] {
+				continue
+			}
+			environmentID := live[name].ID
+			planner.environmentDeletions = append(planner.environmentDeletions, ManifestChange{
+				Action: ManifestChange_Action_Delete,
+				Kind:   ManifestChange_Kind_Environment,
+				Name:   name,
+				ID:     *environmentID,
+				apply: func(ctx context.Context) (string, error) {
+					_, _, err := planner.service.DeleteProjectEnvironmentWithContext(ctx, &DeleteProjectEnvironmentOptions{
+						ProjectID: core.StringPtr(planner.plan.ProjectID),
+						ID:        environmentID,
+						Headers:   planner.headers,
+					})
+					return "", err
+				},
+			})
+		}
+	}
+	return nil
+}
+
+// configProperties returns the definition properties of a manifest configuration, with the ID of its environment and
+// the IDs of the configurations of its stack members. Members are matched by name to the configurations of the
+// project when their config_id is not set. The second result names the properties that refer to resources that do not
+// exist yet.
+func (planner *manifestPlanner) configProperties(config ManifestConfig) (map[string]interface{}, []string) {
+	var pending []string
+	properties := definitionProperties(config.Definition)
+	if config.Environment != nil {
+		if environmentID, exists := planner.environmentIDs[*config.Environment]; exists {
+			properties["environment_id"] = environmentID
+		} else {
+			pending = append(pending, "environment_id")
+		}
+	}
+	members, _ := properties["members"].([]interface{})
+	for _, member := range members {
+		member, _ := member.(map[string]interface{})
+		if member == nil || member["config_id"] != nil {
+			continue
+		}
+		name, _ := member["name"].(string)
+		if configID, exists := planner.configIDs[name]; exists {
+			member["config_id"] = configID
+		} else if !containsString(pending, "members") {
+			pending = append(pending, "members")
+		}
+	}
+	return properties, pending
+}
+
+func (planner *manifestPlanner) planConfigs(ctx context.Context) error {
+	live := make(map[string]ProjectConfigSummary)
+	var liveNames []string
+	if planner.plan.ProjectID != "" {
+		pager, err := planner.service.NewConfigsPager(&ListConfigsOptions{
+			ProjectID: core.StringPtr(planner.plan.ProjectID),
+			Headers:   planner.headers,
+		})
+		if err != nil {
+			return err
+		}
+		configs, err := pager.GetAllWithContext(ctx)
+		if err != nil {
+			return core.RepurposeSDKProblem(err, "list-configs-error")
+		}
+		for _, config := range configs {
+			if config.Definition == nil || config.Definition.Name == nil {
+				continue
+			}
+			live[*config.Definition.Name] = config
+			liveNames = append(liveNames, *config.Definition.Name)
+			planner.configIDs[*config.Definition.Name] = *config.ID
+		}
+	}
+
+	wanted := make(map[string]bool)
+	for _, config := range planner.manifest.Configs {
+		config := config
+		name := prototypeName(config.Definition)
+		wanted[name] = true
+		summary, exists := live[name]
+		if !exists {
+			planner.addChange(ManifestChange{
+				Action: ManifestChange_Action_Create,
+				Kind:   ManifestChange_Kind_Config,
+				Name:   name,
+				apply: func(ctx context.Context) (string, error) {
+					properties, _ := planner.configProperties(config)
+					var definition *ProjectConfigDefinitionPrototype
+					if err := unmarshalProperties(properties, nil, &definition, UnmarshalProjectConfigDefinitionPrototype); err != nil {
+						return "", err
+					}
+					created, _, err := planner.service.CreateConfigWithContext(ctx, &CreateConfigOptions{
+						ProjectID:  core.StringPtr(planner.plan.ProjectID),
+						Definition: definition,
+						Schematics: config.Schematics,
+						Headers:    planner.headers,
+					})
+					if err != nil {
+						return "", err
+					}
+					planner.configIDs[name] = *created.ID
+					if config.StackDefinition != nil {
+						_, _, err = planner.service.CreateStackDefinitionWithContext(ctx, &CreateStackDefinitionOptions{
+							ProjectID:       core.StringPtr(planner.plan.ProjectID),
+							ID:              created.ID,
+							StackDefinition: config.StackDefinition,
+							Headers:         planner.headers,
+						})
+						if err != nil {
+							return *created.ID, err
+						}
+					}
+					return *created.ID, nil
+				},
+			})
+			continue
+		}
+
+		liveConfig, _, err := planner.service.GetConfigWithContext(ctx, &GetConfigOptions{
+			ProjectID: core.StringPtr(planner.plan.ProjectID),
+			ID:        summary.ID,
+			Headers:   planner.headers,
+		})
+		if err != nil {
+			return core.RepurposeSDKProblem(err, "get-config-error")
+		}
+		properties, pending := planner.configProperties(config)
+		changed := changedProperties(properties, definitionProperties(definitionResponse(liveConfig.Definition)))
+		for _, name := range pending {
+			if !containsString(changed, name) {
+				changed = append(changed, name)
+			}
+		}
+		sort.Strings(changed)
+		if len(changed) > 0 {
+			planner.addChange(ManifestChange{
+				Action:     ManifestChange_Action_Update,
+				Kind:       ManifestChange_Kind_Config,
+				Name:       name,
+				ID:         *summary.ID,
+				Properties: changed,
+				apply: func(ctx context.Context) (string, error) {
+					properties, _ := planner.configProperties(config)
+					var patch *ProjectConfigDefinitionPatch
+					if err := unmarshalProperties(properties, changed, &patch, UnmarshalProjectConfigDefinitionPatch); err != nil {
+						return "", err
+					}
+					_, _, err := planner.service.UpdateConfigWithContext(ctx, &UpdateConfigOptions{
+						ProjectID:  core.StringPtr(planner.plan.ProjectID),
+						ID:         summary.ID,
+						Definition: patch,
+						Headers:    planner.headers,
+					})
+					return "", err
+				},
+			})
+		}
+	}
+
+	if planner.prune {
+		for _, name := range liveNames {
+			if wanted[name] {
+				continue
+			}
+			configID := live[name].ID
+			planner.addChange(ManifestChange{
+				Action: ManifestChange_Action_Delete,
+				Kind:   ManifestChange_Kind_Config,
+				Name:   name,
+				ID:     *configID,
+				apply: func(ctx context.Context) (string, error) {
+					_, _, err := planner.actions.DeleteConfigWithContext(ctx, &DeleteConfigOptions{
+						ProjectID: core.StringPtr(planner.plan.ProjectID),
+						ID:        configID,
+						Headers:   planner.headers,
+					})
+					return "", err
+				},
+			})
+		}
+	}
+	return nil
+}
+
+// changedProperties returns the sorted names of the desired properties whose values differ from the live ones. The
+// secrets that the service masks or leaves out of its responses cannot be compared, so they are not reported as
+// changed: a change of a secret alone is not applied.
+func changedProperties(desired map[string]interface{}, live map[string]interface{}) []string {
+	var changed []string
+	for name, value := range desired {
+		if !equalLiveProperty("", name, value, live[name]) {
+			changed = append(changed, name)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// equalLiveProperty returns true if the desired value of a property equals its live value, or if the live value is a
+// secret that the service masked or left out. Objects are compared by the keys of the desired object only, and arrays
+// item by item, so that the keys that the service adds to the live value are ignored. The parent is the name of the
+// property that holds the object of the property.
+func equalLiveProperty(parent string, name string, desired interface{}, live interface{}) bool {
+	if isMaskedValue(live) || live == nil && isSecretProperty(parent, name) {
+		return true
+	}
+	switch desiredValue := desired.(type) {
+	case map[string]interface{}:
+		liveObject, ok := live.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for property, value := range desiredValue {
+			if !equalLiveProperty(name, property, value, liveObject[property]) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		liveArray, ok := live.([]interface{})
+		if !ok || len(liveArray) != len(desiredValue) {
+			return false
+		}
+		for i := range desiredValue {
+			if !equalLiveProperty(parent, name, desiredValue[i], liveArray[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(desired, live)
+}
+
+// unmarshalProperties unmarshals the named properties, or all of them if names is nil, into a model.
+func unmarshalProperties(properties map[string]interface{}, names []string, result interface{}, unmarshaller core.ModelUnmarshaller) error {
+	selected := properties
+	if names != nil {
+		selected = make(map[string]interface{}, len(names))
+		for _, name := range names {
+			selected[name] = properties[name]
+		}
+	}
+	raw := make(map[string]json.RawMessage, len(selected))
+	for name, value := range selected {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return core.SDKErrorf(err, "", "marshal-properties-error", common.GetComponentInfo())
+		}
+		raw[name] = data
+	}
+	err := core.UnmarshalModel(raw, "", result, unmarshaller)
+	if err != nil {
+		return core.SDKErrorf(err, "", "unmarshal-properties-error", common.GetComponentInfo())
+	}
+	return nil
+}
@@ -0,0 +1,312 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/project-go-sdk/common"
+)
+
+// ManifestSecretPlaceholder is the value that replaces the secrets of an exported manifest: the API keys of
+// authorizations, the token of the project store and the values of secure inputs. ApplyManifest rejects a manifest
+// that still contains it.
+const ManifestSecretPlaceholder = "<secret>"
+
+// ExportProjectOptions : The ExportProject options.
+type ExportProjectOptions struct {
+	// The unique project ID.
+	ID *string `json:"id" validate:"required,ne="`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewExportProjectOptions : Instantiate ExportProjectOptions
+func (*ProjectV1) NewExportProjectOptions(id string) *ExportProjectOptions {
+	return &ExportProjectOptions{
+		ID: core.StringPtr(id),
+	}
+}
+
+// SetID : Allow user to set ID
+func (_options *ExportProjectOptions) SetID(id string) *ExportProjectOptions {
+	_options.ID = core.StringPtr(id)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *ExportProjectOptions) SetHeaders(param map[string]string) *ExportProjectOptions {
+	options.Headers = param
+	return options
+}
+
+// ExportProject : Export a project to a manifest
+// Read the project, its environments and its configurations, with the stack definitions of the stack configurations,
+// and return them as a manifest that ApplyManifest can apply to another project or account. The manifest holds only
+// the definitions: the IDs, hrefs, timestamps and states that the service manages are left out. Configurations refer
+// to their environments and stack members by name instead of by ID, and stack configurations come after the other
+// configurations so that their members are created first. Secrets are replaced with ManifestSecretPlaceholder: the
+// API keys, the store token, the inputs that the service masks, and the inputs and defaults of the password variables
+// of stack definitions.
+func (project *ProjectV1) ExportProject(exportProjectOptions *ExportProjectOptions) (result *Manifest, err error) {
+	result, err = project.ExportProjectWithContext(context.Background(), exportProjectOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ExportProjectWithContext is an alternate form of the ExportProject method which supports a Context parameter
+func (project *ProjectV1) ExportProjectWithContext(ctx context.Context, exportProjectOptions *ExportProjectOptions) (result *Manifest, err error) {
+	err = core.ValidateNotNil(exportProjectOptions, "exportProjectOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(exportProjectOptions, "exportProjectOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	headers := exportProjectOptions.Headers
+
+	live, _, err := project.GetProjectWithContext(ctx, &GetProjectOptions{ID: exportProjectOptions.ID, Headers: headers})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-project-error")
+		return
+	}
+	manifest := &Manifest{
+		Project: &ManifestProject{
+			Location:      live.Location,
+			ResourceGroup: live.ResourceGroup,
+		},
+	}
+	err = unmarshalProperties(exportProperties(live.Definition, nil), nil, &manifest.Project.Definition, UnmarshalProjectPrototypeDefinition)
+	if err != nil {
+		return
+	}
+
+	environmentsPager, err := project.NewProjectEnvironmentsPager(&ListProjectEnvironmentsOptions{ProjectID: live.ID, Headers: headers})
+	if err != nil {
+		return
+	}
+	environments, err := environmentsPager.GetAllWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-environments-error")
+		return
+	}
+	environmentNames := make(map[string]string)
+	for _, environment := range environments {
+		exported := ManifestEnvironment{}
+		err = unmarshalProperties(exportProperties(environment.Definition, nil), nil, &exported.Definition, UnmarshalEnvironmentDefinitionRequiredProperties)
+		if err != nil {
+			return
+		}
+		environmentNames[*environment.ID] = core.StringNilMapper(exported.Definition.Name)
+		manifest.Environments = append(manifest.Environments, exported)
+	}
+
+	configsPager, err := project.NewConfigsPager(&ListConfigsOptions{ProjectID: live.ID, Headers: headers})
+	if err != nil {
+		return
+	}
+	summaries, err := configsPager.GetAllWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-configs-error")
+		return
+	}
+	configs := make([]*ProjectConfig, 0, len(summaries))
+	configNames := make(map[string]string)
+	for _, summary := range summaries {
+		var config *ProjectConfig
+		config, _, err = project.GetConfigWithContext(ctx, &GetConfigOptions{ProjectID: live.ID, ID: summary.ID, Headers: headers})
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "get-config-error")
+			return
+		}
+		configs = append(configs, config)
+		if definition := definitionResponse(config.Definition); definition != nil {
+			configNames[*config.ID] = core.StringNilMapper(definition.Name)
+		}
+	}
+	sort.SliceStable(configs, func(i, j int) bool {
+		return !isStackConfig(configs[i]) && isStackConfig(configs[j])
+	})
+
+	for _, config := range configs {
+		var exported ManifestConfig
+		exported, err = project.exportConfig(ctx, config, environmentNames, configNames, headers)
+		if err != nil {
+			return
+		}
+		manifest.Configs = append(manifest.Configs, exported)
+	}
+
+	result = manifest
+	return
+}
+
+// exportConfig returns the manifest configuration of a live configuration.
+func (project *ProjectV1) exportConfig(ctx context.Context, config *ProjectConfig, environmentNames map[string]string,
+	configNames map[string]string, headers map[string]string) (exported ManifestConfig, err error) {
+	// The input variables of the stack definition of a stack configuration say which of its inputs are secure.
+	var schema *InputSchema
+	if isStackConfig(config) {
+		var stackDefinition *StackDefinition
+		stackDefinition, err = project.findStackDefinition(ctx, config.Project.ID, config.ID, headers)
+		if err != nil {
+			return
+		}
+		if stackDefinition != nil && stackDefinition.StackDefinition != nil {
+			schema = NewInputSchema(stackDefinition.StackDefinition.Inputs)
+			exported.StackDefinition = &StackDefinitionBlockPrototype{
+				Inputs:  exportInputVariables(stackDefinition.StackDefinition.Inputs),
+				Outputs: stackDefinition.StackDefinition.Outputs,
+			}
+		}
+	}
+
+	properties := exportProperties(definitionResponse(config.Definition), schema)
+	// The configurations that a configuration uses are computed by the service from its inputs.
+	delete(properties, "uses")
+	if environmentID, ok := properties["environment_id"].(string); ok {
+		if name, found := environmentNames[environmentID]; found {
+			exported.Environment = core.StringPtr(name)
+			delete(properties, "environment_id")
+		}
+	}
+	members, _ := properties["members"].([]interface{})
+	for _, member := range members {
+		if member, ok := member.(map[string]interface{}); ok {
+			configID, _ := member["config_id"].(string)
+			if name, found := configNames[configID]; found && name == member["name"] {
+				delete(member, "config_id")
+			}
+		}
+	}
+	var definition *ProjectConfigDefinitionPrototype
+	err = unmarshalProperties(properties, nil, &definition, UnmarshalProjectConfigDefinitionPrototype)
+	if err != nil {
+		return
+	}
+	exported.Definition = definition
+	return
+}
+
+// findStackDefinition gets the stack definition of a stack configuration. It returns nil if the stack definition has
+// not been created yet.
+func (project *ProjectV1) findStackDefinition(ctx context.Context, projectID *string, configID *string,
+	headers map[string]string) (*StackDefinition, error) {
+	stackDefinition, response, err := project.GetStackDefinitionWithContext(ctx, &GetStackDefinitionOptions{
+		ProjectID: projectID,
+		ID:        configID,
+		Headers:   headers,
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, core.RepurposeSDKProblem(err, "get-stack-definition-error")
+	}
+	return stackDefinition, nil
+}
+
+func isStackConfig(config *ProjectConfig) bool {
+	return core.StringNilMapper(config.DeploymentModel) == ProjectConfig_DeploymentModel_Stack
+}
+
+// exportProperties returns the properties of a definition with its secrets replaced with ManifestSecretPlaceholder:
+// the API key of the authorizations, the token of the store, the inputs that the service masked and the secure inputs
+// of the schema, which may be nil.
+func exportProperties(definition interface{}, schema *InputSchema) map[string]interface{} {
+	properties := definitionProperties(definition)
+	for container, object := range properties {
+		object, ok := object.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for name, value := range object {
+			if secret, ok := value.(string); ok && secret != "" && isSecretProperty(container, name) {
+				object[name] = ManifestSecretPlaceholder
+			}
+		}
+	}
+	if inputs, ok := properties["inputs"].(map[string]interface{}); ok {
+		for name, value := range inputs {
+			if isMaskedValue(value) || isSecureInput(schema, name) && value != nil && !IsInputReference(value) {
+				inputs[name] = ManifestSecretPlaceholder
+			}
+		}
+	}
+	return properties
+}
+
+// exportInputVariables returns a copy of the input variables of a stack definition with the defaults of the password
+// variables replaced with ManifestSecretPlaceholder.
+func exportInputVariables(variables []StackDefinitionInputVariable) []StackDefinitionInputVariable {
+	if variables == nil {
+		return nil
+	}
+	exported := make([]StackDefinitionInputVariable, len(variables))
+	for i, variable := range variables {
+		if core.StringNilMapper(variable.Type) == StackDefinitionInputVariable_Type_Password && variable.Default != nil && !IsInputReference(variable.Default) {
+			variable.Default = ManifestSecretPlaceholder
+		}
+		exported[i] = variable
+	}
+	return exported
+}
+
+// secretPlaceholders returns the paths of the values of the manifest that are ManifestSecretPlaceholder.
+func (manifest *Manifest) secretPlaceholders() (paths []string) {
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return nil
+	}
+	var document interface{}
+	if err = json.Unmarshal(data, &document); err != nil {
+		return nil
+	}
+	var walk func(value interface{}, path string)
+	walk = func(value interface{}, path string) {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			names := make([]string, 0, len(v))
+			for name := range v {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				walk(v[name], path+"."+name)
+			}
+		case []interface{}:
+			for i, item := range v {
+				walk(item, fmt.Sprintf("%s[%d]", path, i))
+			}
+		case string:
+			if v == ManifestSecretPlaceholder {
+				paths = append(paths, path[1:])
+			}
+		}
+	}
+	walk(document, "")
+	return paths
+}
@@ -0,0 +1,436 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/project-go-sdk/projectv1"
+	"github.com/IBM/project-go-sdk/projectv1fake"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const testManifestYAML = `
+project:
+  location: us-south
+  resource_group: Default
+  definition:
+    name: my-project
+    description: The project of the manifest.
+environments:
+  - definition:
+      name: dev
+      description: Development.
+configs:
+  - environment: dev
+    definition:
+      name: network
+      locator_id: 1082e7d2-5e2f-0a11-a3bc-f88a8e1931fc.018edf04-e772-4ca2-9785-03e8e03bef72-global
+      inputs:
+        region: us-south
+        enabled: true
+`
+
+var _ = Describe(`Manifest`, func() {
+	It(`Parses a YAML manifest`, func() {
+		manifest, err := projectv1.ParseManifest([]byte(testManifestYAML))
+		Expect(err).To(BeNil())
+		Expect(*manifest.Project.Location).To(Equal("us-south"))
+		Expect(*manifest.Project.Definition.Name).To(Equal("my-project"))
+		Expect(manifest.Environments).To(HaveLen(1))
+		Expect(*manifest.Environments[0].Definition.Name).To(Equal("dev"))
+		Expect(manifest.Configs).To(HaveLen(1))
+		Expect(*manifest.Configs[0].Environment).To(Equal("dev"))
+		definition := manifest.Configs[0].Definition.(*projectv1.ProjectConfigDefinitionPrototype)
+		Expect(*definition.Name).To(Equal("network"))
+		Expect(definition.Inputs).To(Equal(map[string]interface{}{"region": "us-south", "enabled": true}))
+	})
+	It(`Writes a manifest that parses to the same manifest`, func() {
+		manifest, err := projectv1.ParseManifest([]byte(testManifestYAML))
+		Expect(err).To(BeNil())
+
+		data, err := manifest.YAML()
+		Expect(err).To(BeNil())
+		fromYAML, err := projectv1.ParseManifest(data)
+		Expect(err).To(BeNil())
+		Expect(fromYAML).To(Equal(manifest))
+
+		data, err = manifest.JSON()
+		Expect(err).To(BeNil())
+		fromJSON, err := projectv1.ParseManifest(data)
+		Expect(err).To(BeNil())
+		Expect(fromJSON).To(Equal(manifest))
+	})
+	It(`Rejects invalid manifests`, func() {
+		_, err := projectv1.ParseManifest([]byte(`- project`))
+		Expect(err).ToNot(BeNil())
+		_, err = projectv1.ParseManifest([]byte(`environments: []`))
+		Expect(err).ToNot(BeNil())
+
+		_, err = projectv1.ParseManifest([]byte(`
+project:
+  definition:
+    name: my-project
+configs:
+  - environment: prod
+    definition:
+      name: network
+`))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("prod"))
+
+		_, err = projectv1.ParseManifest([]byte(`
+project:
+  definition:
+    name: my-project
+configs:
+  - definition:
+      name: network
+  - definition:
+      name: network
+`))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("network"))
+	})
+})
+
+var _ = Describe(`ApplyManifest`, func() {
+	var server *projectv1fake.Server
+	var projectService *projectv1.ProjectV1
+	var manifest *projectv1.Manifest
+
+	BeforeEach(func() {
+		var err error
+		server = projectv1fake.NewServer()
+		projectService, err = server.NewProjectV1()
+		Expect(err).To(BeNil())
+		manifest, err = projectv1.ParseManifest([]byte(testManifestYAML))
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	apply := func(dryRun bool, prune bool) *projectv1.ManifestPlan {
+		plan, err := projectService.ApplyManifest(projectService.NewApplyManifestOptions(manifest).
+			SetDryRun(dryRun).
+			SetPrune(prune))
+		Expect(err).To(BeNil())
+		return plan
+	}
+
+	It(`Plans the creation of a project in a dry run`, func() {
+		plan := apply(true, false)
+		Expect(plan.DryRun).To(BeTrue())
+		Expect(plan.ProjectID).To(BeEmpty())
+		Expect(plan.String()).To(Equal("+ project my-project\n+ environment dev\n+ config network\n"))
+		Expect(plan.Changes[0].Applied).To(BeFalse())
+
+		projects, _, err := projectService.ListProjects(projectService.NewListProjectsOptions())
+		Expect(err).To(BeNil())
+		Expect(projects.Projects).To(BeEmpty())
+	})
+	It(`Creates a project and has no changes to apply again`, func() {
+		plan := apply(false, false)
+		Expect(plan.ProjectID).ToNot(BeEmpty())
+		Expect(plan.Changes).To(HaveLen(3))
+		for _, change := range plan.Changes {
+			Expect(change.Applied).To(BeTrue())
+			Expect(change.ID).ToNot(BeEmpty())
+		}
+
+		config, _, err := projectService.GetConfig(projectService.NewGetConfigOptions(plan.ProjectID, plan.Changes[2].ID))
+		Expect(err).To(BeNil())
+		definition := config.Definition.(*projectv1.ProjectConfigDefinitionResponse)
+		Expect(*definition.EnvironmentID).To(Equal(plan.Changes[1].ID))
+		Expect(definition.Inputs["region"]).To(Equal("us-south"))
+
+		plan = apply(false, false)
+		Expect(plan.HasChanges()).To(BeFalse())
+		Expect(plan.String()).To(Equal("No changes.\n"))
+	})
+	It(`Updates the changed properties and prunes what is not in the manifest`, func() {
+		created := apply(false, false)
+		_, _, err := projectService.CreateConfig(projectService.NewCreateConfigOptions(created.ProjectID,
+			&projectv1.ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype{
+				Name: core.StringPtr("old"),
+			}))
+		Expect(err).To(BeNil())
+
+		manifest.Project.Definition.Description = core.StringPtr("A new description.")
+		manifest.Environments = append(manifest.Environments, projectv1.ManifestEnvironment{
+			Definition: &projectv1.EnvironmentDefinitionRequiredProperties{Name: core.StringPtr("prod")},
+		})
+		manifest.Configs[0].Environment = core.StringPtr("prod")
+		definition := manifest.Configs[0].Definition.(*projectv1.ProjectConfigDefinitionPrototype)
+		definition.Inputs["region"] = "eu-de"
+
+		plan := apply(true, true)
+		Expect(plan.ProjectID).To(Equal(created.ProjectID))
+		Expect(plan.String()).To(Equal("~ project my-project (description)\n" +
+			"+ environment prod\n" +
+			"~ config network (environment_id, inputs)\n" +
+			"- config old\n"))
+
+		manifest.Environments = manifest.Environments[1:]
+		plan = apply(false, true)
+		Expect(plan.String()).To(Equal("~ project my-project (description)\n" +
+			"+ environment prod\n" +
+			"~ config network (environment_id, inputs)\n" +
+			"- config old\n" +
+			"- environment dev\n"))
+
+		environments, _, err := projectService.ListProjectEnvironments(projectService.NewListProjectEnvironmentsOptions(created.ProjectID))
+		Expect(err).To(BeNil())
+		Expect(environments.Environments).To(HaveLen(1))
+		Expect(*environments.Environments[0].ID).To(Equal(plan.Changes[1].ID))
+		configs, _, err := projectService.ListConfigs(projectService.NewListConfigsOptions(created.ProjectID))
+		Expect(err).To(BeNil())
+		Expect(configs.Configs).To(HaveLen(1))
+
+		Expect(apply(true, true).HasChanges()).To(BeFalse())
+	})
+	It(`Reports the changes that were applied before an error`, func() {
+		configs := manifest.Configs
+		manifest.Configs = nil
+		created := apply(false, false)
+
+		manifest.Configs = configs
+		manifest.Environments = append(manifest.Environments, projectv1.ManifestEnvironment{
+			Definition: &projectv1.EnvironmentDefinitionRequiredProperties{Name: core.StringPtr("prod")},
+		})
+		server.InjectError("POST", "/v1/projects/"+created.ProjectID+"/configs", 500, "internal error")
+		plan, err := projectService.ApplyManifest(projectService.NewApplyManifestOptions(manifest))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("internal error"))
+		Expect(plan.Changes).To(HaveLen(2))
+		Expect(plan.Changes[0].Applied).To(BeTrue())
+		Expect(plan.Changes[1].Applied).To(BeFalse())
+	})
+	It(`Does not report the secrets that the service masks as changed`, func() {
+		// The service masks the API keys and the values of secure inputs in its responses.
+		masked := regexp.MustCompile(`"(api_key|db_password)":"[^"]*"`)
+		maskingServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			recorder := httptest.NewRecorder()
+			server.ServeHTTP(recorder, req)
+			for name, values := range recorder.Header() {
+				res.Header()[name] = values
+			}
+			res.Header().Del("Content-Length")
+			res.WriteHeader(recorder.Code)
+			res.Write(masked.ReplaceAll(recorder.Body.Bytes(), []byte(`"$1":"****"`)))
+		}))
+		defer maskingServer.Close()
+		var err error
+		projectService, err = projectv1.NewProjectV1(&projectv1.ProjectV1Options{
+			URL:           maskingServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+
+		definition := manifest.Configs[0].Definition.(*projectv1.ProjectConfigDefinitionPrototype)
+		definition.Authorizations = &projectv1.ProjectConfigAuth{Method: core.StringPtr("api_key"), ApiKey: core.StringPtr("my-api-key")}
+		definition.Inputs["db_password"] = "my-password"
+		Expect(apply(false, false).Changes).To(HaveLen(3))
+
+		plan := apply(true, false)
+		Expect(plan.String()).To(Equal("No changes.\n"))
+
+		definition.Inputs["region"] = "eu-de"
+		plan = apply(true, false)
+		Expect(plan.String()).To(Equal("~ config network (inputs)\n"))
+	})
+	It(`Does not report the keys that the service adds as changed`, func() {
+		definition := manifest.Configs[0].Definition.(*projectv1.ProjectConfigDefinitionPrototype)
+		definition.Authorizations = &projectv1.ProjectConfigAuth{Method: core.StringPtr("trusted_profile")}
+		created := apply(false, false)
+
+		// The service adds defaults to the inputs and the authorizations.
+		_, _, err := projectService.UpdateConfig(projectService.NewUpdateConfigOptions(created.ProjectID, created.Changes[2].ID,
+			&projectv1.ProjectConfigDefinitionPatchDAConfigDefinitionPropertiesPatch{
+				Inputs: map[string]interface{}{"region": "us-south", "enabled": true, "zone_count": 3},
+				Authorizations: &projectv1.ProjectConfigAuth{
+					Method:           core.StringPtr("trusted_profile"),
+					TrustedProfileID: core.StringPtr("Profile-1234"),
+				},
+			}))
+		Expect(err).To(BeNil())
+		Expect(apply(true, false).String()).To(Equal("No changes.\n"))
+
+		definition.Inputs["enabled"] = false
+		Expect(apply(true, false).String()).To(Equal("~ config network (inputs)\n"))
+	})
+	It(`Requires a location to create a project`, func() {
+		manifest.Project.Location = nil
+		_, err := projectService.ApplyManifest(projectService.NewApplyManifestOptions(manifest))
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Returns an error for a nil manifest`, func() {
+		_, err := projectService.ApplyManifest(projectService.NewApplyManifestOptions(nil))
+		Expect(err).ToNot(BeNil())
+	})
+})
+
+var _ = Describe(`ExportProject`, func() {
+	var server *projectv1fake.Server
+	var projectService *projectv1.ProjectV1
+
+	BeforeEach(func() {
+		var err error
+		server = projectv1fake.NewServer()
+		projectService, err = server.NewProjectV1()
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	// createProject creates a project with a store token, an environment with an API key, a configuration in the
+	// environment with an input that the service masked, and a stack of that configuration with a stack definition that
+	// has a password variable.
+	createProject := func() string {
+		project, _, err := projectService.CreateProject(projectService.NewCreateProjectOptions(
+			&projectv1.ProjectPrototypeDefinition{
+				Name: core.StringPtr("my-project"),
+				Store: &projectv1.ProjectDefinitionStore{
+					Type:  core.StringPtr("gh"),
+					URL:   core.StringPtr("https://github.com/example/configs"),
+					Token: core.StringPtr("secret-store-token"),
+				},
+			}, "us-south", "Default"))
+		Expect(err).To(BeNil())
+		environment, _, err := projectService.CreateProjectEnvironment(projectService.NewCreateProjectEnvironmentOptions(*project.ID,
+			&projectv1.EnvironmentDefinitionRequiredProperties{
+				Name: core.StringPtr("dev"),
+				Authorizations: &projectv1.ProjectConfigAuth{
+					Method: core.StringPtr("api_key"),
+					ApiKey: core.StringPtr("secret-api-key"),
+				},
+			}))
+		Expect(err).To(BeNil())
+		stack, _, err := projectService.CreateConfig(projectService.NewCreateConfigOptions(*project.ID,
+			&projectv1.ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype{
+				Name:    core.StringPtr("stack"),
+				Inputs:  map[string]interface{}{"admin_password": "secret-admin-password"},
+				Members: []projectv1.StackMember{{Name: core.StringPtr("network"), ConfigID: core.StringPtr("placeholder")}},
+			}))
+		Expect(err).To(BeNil())
+		network, _, err := projectService.CreateConfig(projectService.NewCreateConfigOptions(*project.ID,
+			&projectv1.ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype{
+				Name:          core.StringPtr("network"),
+				LocatorID:     core.StringPtr("1082e7d2-5e2f-0a11-a3bc-f88a8e1931fc.018edf04-e772-4ca2-9785-03e8e03bef72-global"),
+				EnvironmentID: environment.ID,
+				Inputs:        map[string]interface{}{"region": "us-south", "db_password": "********"},
+				Settings:      map[string]interface{}{"TF_LOG": "debug"},
+			}))
+		Expect(err).To(BeNil())
+		patch := &projectv1.ProjectConfigDefinitionPatch{
+			Members: []projectv1.StackMember{{Name: core.StringPtr("network"), ConfigID: network.ID}},
+		}
+		_, _, err = projectService.UpdateConfig(projectService.NewUpdateConfigOptions(*project.ID, *stack.ID, patch))
+		Expect(err).To(BeNil())
+		_, _, err = projectService.CreateStackDefinition(projectService.NewCreateStackDefinitionOptions(*project.ID, *stack.ID,
+			&projectv1.StackDefinitionBlockPrototype{Inputs: []projectv1.StackDefinitionInputVariable{
+				{Name: core.StringPtr("region"), Type: core.StringPtr("string")},
+				{Name: core.StringPtr("admin_password"), Type: core.StringPtr(projectv1.StackDefinitionInputVariable_Type_Password), Default: "secret-default-password"},
+			}}))
+		Expect(err).To(BeNil())
+		return *project.ID
+	}
+
+	It(`Exports a project without IDs and secrets`, func() {
+		projectID := createProject()
+		manifest, err := projectService.ExportProject(projectService.NewExportProjectOptions(projectID))
+		Expect(err).To(BeNil())
+
+		Expect(manifest.Project.ID).To(BeNil())
+		Expect(*manifest.Project.Location).To(Equal("us-south"))
+		Expect(*manifest.Project.ResourceGroup).To(Equal("Default"))
+		Expect(*manifest.Project.Definition.Store.Token).To(Equal(projectv1.ManifestSecretPlaceholder))
+		Expect(manifest.Environments).To(HaveLen(1))
+		Expect(*manifest.Environments[0].Definition.Authorizations.ApiKey).To(Equal(projectv1.ManifestSecretPlaceholder))
+
+		Expect(manifest.Configs).To(HaveLen(2))
+		network := manifest.Configs[0].Definition.(*projectv1.ProjectConfigDefinitionPrototype)
+		Expect(*network.Name).To(Equal("network"))
+		Expect(network.EnvironmentID).To(BeNil())
+		Expect(*manifest.Configs[0].Environment).To(Equal("dev"))
+		Expect(network.Inputs).To(Equal(map[string]interface{}{"region": "us-south", "db_password": projectv1.ManifestSecretPlaceholder}))
+		Expect(network.Settings).To(Equal(map[string]interface{}{"TF_LOG": "debug"}))
+		Expect(manifest.Configs[0].StackDefinition).To(BeNil())
+
+		stack := manifest.Configs[1].Definition.(*projectv1.ProjectConfigDefinitionPrototype)
+		Expect(*stack.Name).To(Equal("stack"))
+		Expect(stack.Members).To(HaveLen(1))
+		Expect(*stack.Members[0].Name).To(Equal("network"))
+		Expect(stack.Members[0].ConfigID).To(BeNil())
+		Expect(stack.Inputs).To(Equal(map[string]interface{}{"admin_password": projectv1.ManifestSecretPlaceholder}))
+		Expect(*manifest.Configs[1].StackDefinition.Inputs[0].Name).To(Equal("region"))
+		Expect(manifest.Configs[1].StackDefinition.Inputs[1].Default).To(Equal(projectv1.ManifestSecretPlaceholder))
+
+		data, err := manifest.YAML()
+		Expect(err).To(BeNil())
+		Expect(string(data)).ToNot(ContainSubstring(projectID))
+		Expect(string(data)).ToNot(ContainSubstring("secret-"))
+	})
+	It(`Exports a manifest that applies to another account`, func() {
+		manifest, err := projectService.ExportProject(projectService.NewExportProjectOptions(createProject()))
+		Expect(err).To(BeNil())
+
+		target := projectv1fake.NewServer()
+		defer target.Close()
+		targetService, err := target.NewProjectV1()
+		Expect(err).To(BeNil())
+
+		_, err = targetService.ApplyManifest(targetService.NewApplyManifestOptions(manifest))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("environments[0].definition.authorizations.api_key"))
+		Expect(err.Error()).To(ContainSubstring("project.definition.store.token"))
+		Expect(err.Error()).To(ContainSubstring("configs[0].definition.inputs.db_password"))
+		Expect(err.Error()).To(ContainSubstring("configs[1].definition.inputs.admin_password"))
+		Expect(err.Error()).To(ContainSubstring("configs[1].stack_definition.inputs[1].default"))
+
+		manifest.Project.Definition.Store.Token = core.StringPtr("other-store-token")
+		manifest.Environments[0].Definition.Authorizations.ApiKey = core.StringPtr("other-api-key")
+		manifest.Configs[0].Definition.(*projectv1.ProjectConfigDefinitionPrototype).Inputs["db_password"] = "other-db-password"
+		manifest.Configs[1].Definition.(*projectv1.ProjectConfigDefinitionPrototype).Inputs["admin_password"] = "other-admin-password"
+		manifest.Configs[1].StackDefinition.Inputs[1].Default = "other-default-password"
+		plan, err := targetService.ApplyManifest(targetService.NewApplyManifestOptions(manifest))
+		Expect(err).To(BeNil())
+		Expect(plan.String()).To(Equal("+ project my-project\n+ environment dev\n+ config network\n+ config stack\n"))
+
+		stack, _, err := targetService.GetConfig(targetService.NewGetConfigOptions(plan.ProjectID, plan.Changes[3].ID))
+		Expect(err).To(BeNil())
+		members := stack.Definition.(*projectv1.ProjectConfigDefinitionResponse).Members
+		Expect(*members[0].ConfigID).To(Equal(plan.Changes[2].ID))
+		stackDefinition, _, err := targetService.GetStackDefinition(targetService.NewGetStackDefinitionOptions(plan.ProjectID, plan.Changes[3].ID))
+		Expect(err).To(BeNil())
+		Expect(*stackDefinition.StackDefinition.Inputs[0].Name).To(Equal("region"))
+
+		plan, err = targetService.ApplyManifest(targetService.NewApplyManifestOptions(manifest))
+		Expect(err).To(BeNil())
+		Expect(plan.HasChanges()).To(BeFalse())
+	})
+	It(`Returns an error for a missing project`, func() {
+		_, err := projectService.ExportProject(projectService.NewExportProjectOptions("missing"))
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,334 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/project-go-sdk/common"
+	"github.com/go-openapi/strfmt"
+)
+
+// GetNeedsAttentionInboxOptions : The GetNeedsAttentionInbox options.
+type GetNeedsAttentionInboxOptions struct {
+	// The unique IDs of the projects to collect the events of. If no IDs are set, the events of all the projects are
+	// collected.
+	ProjectIds []string `json:"project_ids,omitempty"`
+
+	// The severities of the events to keep, from the ProjectConfigNeedsAttentionState_Severity_* values. Use an empty
+	// string for the events that have no severity. If no severities are set, events of every severity are kept.
+	Severities []string `json:"severities,omitempty"`
+
+	// Keep only the events that were triggered at or after this time. Events without a timestamp are dropped when it
+	// is set.
+	Since *strfmt.DateTime `json:"since,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewGetNeedsAttentionInboxOptions : Instantiate GetNeedsAttentionInboxOptions
+func (*ProjectV1) NewGetNeedsAttentionInboxOptions() *GetNeedsAttentionInboxOptions {
+	return &GetNeedsAttentionInboxOptions{}
+}
+
+// SetProjectIds : Allow user to set ProjectIds
+func (_options *GetNeedsAttentionInboxOptions) SetProjectIds(projectIds []string) *GetNeedsAttentionInboxOptions {
+	_options.ProjectIds = projectIds
+	return _options
+}
+
+// SetSeverities : Allow user to set Severities
+func (_options *GetNeedsAttentionInboxOptions) SetSeverities(severities []string) *GetNeedsAttentionInboxOptions {
+	_options.Severities = severities
+	return _options
+}
+
+// SetSince : Allow user to set Since
+func (_options *GetNeedsAttentionInboxOptions) SetSince(since *strfmt.DateTime) *GetNeedsAttentionInboxOptions {
+	_options.Since = since
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *GetNeedsAttentionInboxOptions) SetHeaders(param map[string]string) *GetNeedsAttentionInboxOptions {
+	options.Headers = param
+	return options
+}
+
+// NeedsAttentionItem : An event that requires human action, with the project and configuration it occurred on.
+type NeedsAttentionItem struct {
+	// The id of the event.
+	EventID string `json:"event_id"`
+
+	// The name of the event.
+	Event string `json:"event"`
+
+	// The severity of the event. It is empty for user triggered events, and for events that are only listed in the
+	// cumulative needs attention view of the project.
+	Severity string `json:"severity,omitempty"`
+
+	// An actionable Url that users can access in response to the event.
+	ActionURL string `json:"action_url,omitempty"`
+
+	// The configuration id and version for which a user triggered event occurred.
+	Target string `json:"target,omitempty"`
+
+	// The IAM id of the user that triggered the event.
+	TriggeredBy string `json:"triggered_by,omitempty"`
+
+	// The time at which the event was triggered.
+	Timestamp *strfmt.DateTime `json:"timestamp,omitempty"`
+
+	// The unique project ID.
+	ProjectID string `json:"project_id"`
+
+	// The name of the project.
+	ProjectName string `json:"project_name"`
+
+	// The unique configuration ID.
+	ConfigID string `json:"config_id,omitempty"`
+
+	// The name of the configuration.
+	ConfigName string `json:"config_name,omitempty"`
+
+	// The version number of the configuration.
+	ConfigVersion int64 `json:"config_version,omitempty"`
+}
+
+// NeedsAttentionInbox : The events that require human action, across projects.
+type NeedsAttentionInbox struct {
+	// The time at which the inbox was generated.
+	GeneratedAt strfmt.DateTime `json:"generated_at"`
+
+	// The events, by decreasing severity and then from the most recent. Events without a severity come after the
+	// others, and events without a timestamp come after the others of their severity.
+	Items []NeedsAttentionItem `json:"items"`
+}
+
+// GetNeedsAttentionInbox : Collect the events that require human action across projects
+// List the projects, then the configurations of each project, and merge the needs attention states of the
+// configurations with the cumulative needs attention views of the projects. Events are deduplicated by event ID: an
+// event that is only listed in the view of a project is kept with the properties that the view reports. The events are
+// filtered by the severities and time of the options, and sorted by decreasing severity and then from the most recent.
+func (project *ProjectV1) GetNeedsAttentionInbox(getNeedsAttentionInboxOptions *GetNeedsAttentionInboxOptions) (result *NeedsAttentionInbox, err error) {
+	result, err = project.GetNeedsAttentionInboxWithContext(context.Background(), getNeedsAttentionInboxOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetNeedsAttentionInboxWithContext is an alternate form of the GetNeedsAttentionInbox method which supports a Context parameter
+func (project *ProjectV1) GetNeedsAttentionInboxWithContext(ctx context.Context, getNeedsAttentionInboxOptions *GetNeedsAttentionInboxOptions) (result *NeedsAttentionInbox, err error) {
+	err = core.ValidateNotNil(getNeedsAttentionInboxOptions, "getNeedsAttentionInboxOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(getNeedsAttentionInboxOptions, "getNeedsAttentionInboxOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	headers := getNeedsAttentionInboxOptions.Headers
+
+	var projects []ProjectSummary
+	if len(getNeedsAttentionInboxOptions.ProjectIds) == 0 {
+		var projectsPager *ProjectsPager
+		projectsPager, err = project.NewProjectsPager(&ListProjectsOptions{Headers: headers})
+		if err != nil {
+			return
+		}
+		projects, err = projectsPager.GetAllWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "list-projects-error")
+			return
+		}
+	} else {
+		for _, projectID := range getNeedsAttentionInboxOptions.ProjectIds {
+			var live *Project
+			live, _, err = project.GetProjectWithContext(ctx, &GetProjectOptions{ID: core.StringPtr(projectID), Headers: headers})
+			if err != nil {
+				err = core.RepurposeSDKProblem(err, "get-project-error")
+				return
+			}
+			summary := ProjectSummary{ID: live.ID, CumulativeNeedsAttentionView: live.CumulativeNeedsAttentionView}
+			if live.Definition != nil {
+				summary.Definition = &ProjectDefinitionSummary{Name: live.Definition.Name}
+			}
+			projects = append(projects, summary)
+		}
+	}
+
+	inbox := newNeedsAttentionCollector()
+	for _, summary := range projects {
+		projectID := core.StringNilMapper(summary.ID)
+		projectName := ""
+		if summary.Definition != nil {
+			projectName = core.StringNilMapper(summary.Definition.Name)
+		}
+
+		var configsPager *ConfigsPager
+		configsPager, err = project.NewConfigsPager(&ListConfigsOptions{ProjectID: summary.ID, Headers: headers})
+		if err != nil {
+			return
+		}
+		var configs []ProjectConfigSummary
+		configs, err = configsPager.GetAllWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "list-configs-error")
+			return
+		}
+		configNames := make(map[string]string)
+		for _, configSummary := range configs {
+			var config *ProjectConfig
+			config, _, err = project.GetConfigWithContext(ctx, &GetConfigOptions{ProjectID: summary.ID, ID: configSummary.ID, Headers: headers})
+			if err != nil {
+				err = core.RepurposeSDKProblem(err, "get-config-error")
+				return
+			}
+			configName := ""
+			if definition := definitionResponse(config.Definition); definition != nil {
+				configName = core.StringNilMapper(definition.Name)
+			}
+			configNames[core.StringNilMapper(config.ID)] = configName
+			for _, event := range config.NeedsAttentionState {
+				inbox.add(NeedsAttentionItem{
+					EventID:       core.StringNilMapper(event.EventID),
+					Event:         core.StringNilMapper(event.Event),
+					Severity:      core.StringNilMapper(event.Severity),
+					ActionURL:     core.StringNilMapper(event.ActionURL),
+					Target:        core.StringNilMapper(event.Target),
+					TriggeredBy:   core.StringNilMapper(event.TriggeredBy),
+					Timestamp:     event.Timestamp,
+					ProjectID:     projectID,
+					ProjectName:   projectName,
+					ConfigID:      core.StringNilMapper(config.ID),
+					ConfigName:    configName,
+					ConfigVersion: int64Value(config.Version),
+				})
+			}
+		}
+		for _, event := range summary.CumulativeNeedsAttentionView {
+			configID := core.StringNilMapper(event.ConfigID)
+			inbox.add(NeedsAttentionItem{
+				EventID:       core.StringNilMapper(event.EventID),
+				Event:         core.StringNilMapper(event.Event),
+				ProjectID:     projectID,
+				ProjectName:   projectName,
+				ConfigID:      configID,
+				ConfigName:    configNames[configID],
+				ConfigVersion: int64Value(event.ConfigVersion),
+			})
+		}
+	}
+
+	result = &NeedsAttentionInbox{
+		GeneratedAt: strfmt.DateTime(time.Now().UTC()),
+		Items:       []NeedsAttentionItem{},
+	}
+	for _, item := range inbox.items {
+		if len(getNeedsAttentionInboxOptions.Severities) > 0 && !containsString(getNeedsAttentionInboxOptions.Severities, item.Severity) {
+			continue
+		}
+		if since := getNeedsAttentionInboxOptions.Since; since != nil &&
+			(item.Timestamp == nil || time.Time(*item.Timestamp).Before(time.Time(*since))) {
+			continue
+		}
+		result.Items = append(result.Items, item)
+	}
+	sort.SliceStable(result.Items, func(i, j int) bool {
+		return needsAttentionBefore(&result.Items[i], &result.Items[j])
+	})
+	return
+}
+
+// needsAttentionCollector merges events by event ID, in the order in which they are first added.
+type needsAttentionCollector struct {
+	items   []NeedsAttentionItem
+	indexes map[string]int
+}
+
+func newNeedsAttentionCollector() *needsAttentionCollector {
+	return &needsAttentionCollector{indexes: make(map[string]int)}
+}
+
+// add adds an event, or fills in the properties that are missing from the event with the same ID. Events without an
+// ID cannot be deduplicated and are always added.
+func (collector *needsAttentionCollector) add(item NeedsAttentionItem) {
+	index, found := collector.indexes[item.EventID]
+	if !found || item.EventID == "" {
+		collector.indexes[item.EventID] = len(collector.items)
+		collector.items = append(collector.items, item)
+		return
+	}
+	existing := &collector.items[index]
+	for _, property := range []struct{ value, merged *string }{
+		{&existing.Event, &item.Event},
+		{&existing.Severity, &item.Severity},
+		{&existing.ActionURL, &item.ActionURL},
+		{&existing.Target, &item.Target},
+		{&existing.TriggeredBy, &item.TriggeredBy},
+		{&existing.ConfigID, &item.ConfigID},
+		{&existing.ConfigName, &item.ConfigName},
+	} {
+		if *property.value == "" {
+			*property.value = *property.merged
+		}
+	}
+	if existing.Timestamp == nil {
+		existing.Timestamp = item.Timestamp
+	}
+	if existing.ConfigVersion == 0 {
+		existing.ConfigVersion = item.ConfigVersion
+	}
+}
+
+// int64Value returns the value of an int64 pointer, or 0 for a nil pointer.
+func int64Value(value *int64) int64 {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
+
+// needsAttentionSeverityRanks orders the severities from the most severe. Unknown and missing severities come last.
+var needsAttentionSeverityRanks = map[string]int{
+	ProjectConfigNeedsAttentionState_Severity_Error:   0,
+	ProjectConfigNeedsAttentionState_Severity_Warning: 1,
+	ProjectConfigNeedsAttentionState_Severity_Info:    2,
+}
+
+func needsAttentionSeverityRank(severity string) int {
+	if rank, found := needsAttentionSeverityRanks[severity]; found {
+		return rank
+	}
+	return len(needsAttentionSeverityRanks)
+}
+
+// needsAttentionBefore returns true if an event comes before another in an inbox.
+func needsAttentionBefore(a *NeedsAttentionItem, b *NeedsAttentionItem) bool {
+	if rankA, rankB := needsAttentionSeverityRank(a.Severity), needsAttentionSeverityRank(b.Severity); rankA != rankB {
+		return rankA < rankB
+	}
+	if a.Timestamp == nil || b.Timestamp == nil {
+		return a.Timestamp != nil && b.Timestamp == nil
+	}
+	return time.Time(*a.Timestamp).After(time.Time(*b.Timestamp))
+}
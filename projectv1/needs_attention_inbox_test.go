@@ -0,0 +1,159 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1_test
+
+import (
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/project-go-sdk/projectv1"
+	"github.com/IBM/project-go-sdk/projectv1fake"
+	"github.com/go-openapi/strfmt"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`GetNeedsAttentionInbox`, func() {
+	var server *projectv1fake.Server
+	var projectService *projectv1.ProjectV1
+	var webProjectID string
+	var dataProjectID string
+
+	at := func(hour int) *strfmt.DateTime {
+		timestamp := strfmt.DateTime(time.Date(2026, 3, 1, hour, 0, 0, 0, time.UTC))
+		return &timestamp
+	}
+	eventIDs := func(inbox *projectv1.NeedsAttentionInbox) []string {
+		ids := []string{}
+		for _, item := range inbox.Items {
+			ids = append(ids, item.EventID)
+		}
+		return ids
+	}
+	createProject := func(name string, configName string) (string, string) {
+		project, _, err := projectService.CreateProject(projectService.NewCreateProjectOptions(
+			&projectv1.ProjectPrototypeDefinition{Name: core.StringPtr(name)}, "us-south", "Default"))
+		Expect(err).To(BeNil())
+		config, _, err := projectService.CreateConfig(projectService.NewCreateConfigOptions(*project.ID,
+			&projectv1.ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype{Name: core.StringPtr(configName)}))
+		Expect(err).To(BeNil())
+		return *project.ID, *config.ID
+	}
+
+	BeforeEach(func() {
+		var err error
+		server = projectv1fake.NewServer()
+		projectService, err = server.NewProjectV1()
+		Expect(err).To(BeNil())
+
+		var networkID, databaseID string
+		webProjectID, networkID = createProject("web", "network")
+		dataProjectID, databaseID = createProject("data", "database")
+		createProject("quiet", "idle")
+
+		server.AddNeedsAttention(networkID,
+			projectv1.ProjectConfigNeedsAttentionState{
+				EventID: core.StringPtr("drift"), Event: core.StringPtr("project.config.drift_detected"),
+				Severity: core.StringPtr("WARNING"), Timestamp: at(9), ActionURL: core.StringPtr("https://cloud.ibm.com/drift"),
+			},
+			projectv1.ProjectConfigNeedsAttentionState{
+				EventID: core.StringPtr("validation"), Event: core.StringPtr("project.config.validation_failed"),
+				Severity: core.StringPtr("ERROR"), Timestamp: at(8),
+			},
+			projectv1.ProjectConfigNeedsAttentionState{
+				EventID: core.StringPtr("drift"), Event: core.StringPtr("project.config.drift_detected"),
+				Severity: core.StringPtr("WARNING"), Timestamp: at(9),
+			})
+		server.AddNeedsAttention(databaseID,
+			projectv1.ProjectConfigNeedsAttentionState{
+				EventID: core.StringPtr("deploy"), Event: core.StringPtr("project.config.deploy_failed"),
+				Severity: core.StringPtr("ERROR"), Timestamp: at(10),
+			},
+			projectv1.ProjectConfigNeedsAttentionState{
+				EventID: core.StringPtr("update"), Event: core.StringPtr("project.config.update_available"),
+				Severity: core.StringPtr("INFO"), Timestamp: at(7),
+			},
+			projectv1.ProjectConfigNeedsAttentionState{
+				EventID: core.StringPtr("approval"), Event: core.StringPtr("project.config.approval_requested"),
+				Target: core.StringPtr(databaseID + "@1"), TriggeredBy: core.StringPtr("IBMid-123"), Timestamp: at(11),
+			})
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Merges the events of every project by severity and time`, func() {
+		inbox, err := projectService.GetNeedsAttentionInbox(projectService.NewGetNeedsAttentionInboxOptions())
+		Expect(err).To(BeNil())
+		Expect(eventIDs(inbox)).To(Equal([]string{"deploy", "validation", "drift", "update", "approval"}))
+
+		drift := inbox.Items[2]
+		Expect(drift.ProjectID).To(Equal(webProjectID))
+		Expect(drift.ProjectName).To(Equal("web"))
+		Expect(drift.ConfigName).To(Equal("network"))
+		Expect(drift.ConfigVersion).To(Equal(int64(1)))
+		Expect(drift.Severity).To(Equal(projectv1.ProjectConfigNeedsAttentionState_Severity_Warning))
+		Expect(drift.ActionURL).To(Equal("https://cloud.ibm.com/drift"))
+		Expect(drift.Timestamp).To(Equal(at(9)))
+
+		approval := inbox.Items[4]
+		Expect(approval.ProjectName).To(Equal("data"))
+		Expect(approval.Severity).To(BeEmpty())
+		Expect(approval.TriggeredBy).To(Equal("IBMid-123"))
+	})
+	It(`Filters the events`, func() {
+		inbox, err := projectService.GetNeedsAttentionInbox(projectService.NewGetNeedsAttentionInboxOptions().
+			SetSeverities([]string{projectv1.ProjectConfigNeedsAttentionState_Severity_Error}))
+		Expect(err).To(BeNil())
+		Expect(eventIDs(inbox)).To(Equal([]string{"deploy", "validation"}))
+
+		inbox, err = projectService.GetNeedsAttentionInbox(projectService.NewGetNeedsAttentionInboxOptions().
+			SetSince(at(9)))
+		Expect(err).To(BeNil())
+		Expect(eventIDs(inbox)).To(Equal([]string{"deploy", "drift", "approval"}))
+
+		inbox, err = projectService.GetNeedsAttentionInbox(projectService.NewGetNeedsAttentionInboxOptions().
+			SetProjectIds([]string{dataProjectID}).
+			SetSeverities([]string{projectv1.ProjectConfigNeedsAttentionState_Severity_Info, ""}))
+		Expect(err).To(BeNil())
+		Expect(eventIDs(inbox)).To(Equal([]string{"update", "approval"}))
+	})
+	It(`Returns the error of the requests`, func() {
+		_, err := projectService.GetNeedsAttentionInbox(projectService.NewGetNeedsAttentionInboxOptions().
+			SetProjectIds([]string{"unknown"}))
+		Expect(err).ToNot(BeNil())
+
+		server.InjectError("GET", "/v1/projects/"+webProjectID+"/configs", 500, "boom")
+		_, err = projectService.GetNeedsAttentionInbox(projectService.NewGetNeedsAttentionInboxOptions())
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("boom"))
+
+		_, err = projectService.GetNeedsAttentionInbox(nil)
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Invoke NewGetNeedsAttentionInboxOptions successfully`, func() {
+		options := projectService.NewGetNeedsAttentionInboxOptions().
+			SetProjectIds([]string{"testString"}).
+			SetSeverities([]string{"ERROR"}).
+			SetSince(at(1)).
+			SetHeaders(map[string]string{"foo": "bar"})
+		Expect(options.ProjectIds).To(Equal([]string{"testString"}))
+		Expect(options.Severities).To(Equal([]string{"ERROR"}))
+		Expect(options.Since).To(Equal(at(1)))
+		Expect(options.Headers).To(Equal(map[string]string{"foo": "bar"}))
+	})
+})
@@ -0,0 +1,366 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"regexp"
+	"strconv"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/project-go-sdk/common"
+	"github.com/go-openapi/strfmt"
+)
+
+// DefaultNotificationMaxBodyBytes is the size limit of the notifications that a NotificationReceiver accepts.
+const DefaultNotificationMaxBodyBytes = 1 << 20
+
+// ProjectNotification : A notification about a project that Event Notifications delivered to a webhook, as a
+// CloudEvent.
+type ProjectNotification struct {
+	// The unique ID of the notification.
+	ID string `json:"id"`
+
+	// The source of the notification.
+	Source string `json:"source"`
+
+	// The version of the CloudEvents specification of the notification.
+	SpecVersion string `json:"specversion"`
+
+	// The type of the notification.
+	Type string `json:"type"`
+
+	// The subject of the notification, if any.
+	Subject string `json:"subject,omitempty"`
+
+	// The time at which the notification was produced.
+	Time *strfmt.DateTime `json:"time,omitempty"`
+
+	// The ID of the Event Notifications source that sent the notification.
+	SourceID string `json:"ibmensourceid,omitempty"`
+
+	// The severity of the notification.
+	Severity string `json:"ibmenseverity,omitempty"`
+
+	// The short description of the notification.
+	ShortDescription string `json:"ibmendefaultshort,omitempty"`
+
+	// The long description of the notification.
+	LongDescription string `json:"ibmendefaultlong,omitempty"`
+
+	// The name of the project event, such as "project.config.drift_detected". It is the event property of the data, or
+	// the type of the notification if the data has none.
+	Event string `json:"-"`
+
+	// The unique project ID that the notification is about, if it could be found.
+	ProjectID string `json:"-"`
+
+	// The unique configuration ID that the notification is about, if any.
+	ConfigID string `json:"-"`
+
+	// The version number of the configuration that the notification is about, if any.
+	ConfigVersion int64 `json:"-"`
+
+	// The data of the notification, as JSON.
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// DecodeData decodes the data of the notification into a value.
+func (notification *ProjectNotification) DecodeData(value interface{}) error {
+	if len(notification.Data) == 0 {
+		return core.SDKErrorf(nil, "the notification has no data", "notification-no-data", common.GetComponentInfo())
+	}
+	if err := json.Unmarshal(notification.Data, value); err != nil {
+		return core.SDKErrorf(err, "", "notification-data-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// NotificationHandler : A handler of the notifications that a NotificationReceiver receives.
+type NotificationHandler interface {
+	// HandleNotification handles a notification. An error makes the receiver reply with a server error, so that Event
+	// Notifications delivers the notification again.
+	HandleNotification(ctx context.Context, notification *ProjectNotification) error
+}
+
+// NotificationHandlerFunc : An adapter to use a function as a NotificationHandler.
+type NotificationHandlerFunc func(ctx context.Context, notification *ProjectNotification) error
+
+// HandleNotification calls the function.
+func (f NotificationHandlerFunc) HandleNotification(ctx context.Context, notification *ProjectNotification) error {
+	return f(ctx, notification)
+}
+
+// NotificationVerifier : A check that a request to a NotificationReceiver comes from Event Notifications.
+type NotificationVerifier interface {
+	// Verify returns an error if the request, whose body has been read, must be rejected.
+	Verify(req *http.Request, body []byte) error
+}
+
+// NotificationVerifierFunc : An adapter to use a function as a NotificationVerifier.
+type NotificationVerifierFunc func(req *http.Request, body []byte) error
+
+// Verify calls the function.
+func (f NotificationVerifierFunc) Verify(req *http.Request, body []byte) error {
+	return f(req, body)
+}
+
+// SharedSecretVerifier : Return a verifier that requires a header to hold a secret
+// Add the header to the webhook destination in Event Notifications, as a sensitive header, so that every notification
+// carries the secret. The header is compared in constant time.
+func SharedSecretVerifier(header string, secret string) NotificationVerifier {
+	return NotificationVerifierFunc(func(req *http.Request, body []byte) error {
+		value := req.Header.Get(header)
+		if secret == "" || subtle.ConstantTimeCompare([]byte(value), []byte(secret)) != 1 {
+			return fmt.Errorf("the %s header does not hold the shared secret", header)
+		}
+		return nil
+	})
+}
+
+// NotificationReceiver : An http.Handler that receives the notifications that Event Notifications delivers to a
+// webhook destination for projects, and dispatches them to handlers.
+type NotificationReceiver struct {
+	verifier NotificationVerifier
+
+	// The size limit of the notifications. Defaults to DefaultNotificationMaxBodyBytes.
+	MaxBodyBytes int64
+
+	mutex    sync.RWMutex
+	handlers []notificationRoute
+}
+
+// notificationRoute is a handler with the pattern of the events that it handles.
+type notificationRoute struct {
+	pattern string
+	handler NotificationHandler
+}
+
+// NewNotificationReceiver : Instantiate NotificationReceiver
+// Every request is verified with the verifier, such as SharedSecretVerifier, before it is parsed. To receive the
+// notifications of a project, connect it to an Event Notifications instance, which Project.EventNotificationsCrn
+// reports, and subscribe a webhook destination with the URL of the receiver to the project source.
+func NewNotificationReceiver(verifier NotificationVerifier) (*NotificationReceiver, error) {
+	err := core.ValidateNotNil(verifier, "verifier cannot be nil")
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+	}
+	return &NotificationReceiver{verifier: verifier}, nil
+}
+
+// Handle : Register a handler for the events that match a pattern
+// The pattern is matched against ProjectNotification.Event with path.Match, so "project.config.*" matches every
+// configuration event and "*" matches every event. A notification is dispatched to every handler that matches, in the
+// order in which they are registered.
+func (receiver *NotificationReceiver) Handle(pattern string, handler NotificationHandler) {
+	receiver.mutex.Lock()
+	defer receiver.mutex.Unlock()
+
+	receiver.handlers = append(receiver.handlers, notificationRoute{pattern: pattern, handler: handler})
+}
+
+// HandleFunc : Register a function for the events that match a pattern
+// See Handle.
+func (receiver *NotificationReceiver) HandleFunc(pattern string, handler func(ctx context.Context, notification *ProjectNotification) error) {
+	receiver.Handle(pattern, NotificationHandlerFunc(handler))
+}
+
+// ServeHTTP receives a notification, verifies it, and dispatches it to the handlers. Both the structured and the
+// binary content modes of CloudEvents are accepted, as well as batches. It replies 204 No Content when every handler
+// succeeds, even if no handler matches; 401 Unauthorized when the verifier rejects the request; 400 Bad Request when
+// it is not a CloudEvent; and 500 Internal Server Error when a handler fails, so that the notification is delivered
+// again.
+func (receiver *NotificationReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "Only POST is allowed.", http.StatusMethodNotAllowed)
+		return
+	}
+	maxBodyBytes := receiver.MaxBodyBytes
+	if maxBodyBytes <= 0 {
+		maxBodyBytes = DefaultNotificationMaxBodyBytes
+	}
+	body, err := io.ReadAll(io.LimitReader(req.Body, maxBodyBytes+1))
+	if err != nil {
+		http.Error(w, "The notification could not be read.", http.StatusBadRequest)
+		return
+	}
+	if int64(len(body)) > maxBodyBytes {
+		http.Error(w, "The notification is too large.", http.StatusRequestEntityTooLarge)
+		return
+	}
+	if err = receiver.verifier.Verify(req, body); err != nil {
+		core.GetLogger().Warn("Rejected a project notification: %s", err.Error())
+		http.Error(w, "The notification could not be verified.", http.StatusUnauthorized)
+		return
+	}
+	notifications, err := ParseProjectNotifications(req.Header, body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	failed := false
+	for _, notification := range notifications {
+		for _, handler := range receiver.matchingHandlers(notification.Event) {
+			if err = handler.HandleNotification(req.Context(), notification); err != nil {
+				core.GetLogger().Error("The handler of the project notification '%s' failed: %s", notification.ID, err.Error())
+				failed = true
+			}
+		}
+	}
+	if failed {
+		http.Error(w, "The notification could not be handled.", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// matchingHandlers returns the handlers of an event.
+func (receiver *NotificationReceiver) matchingHandlers(event string) (handlers []NotificationHandler) {
+	receiver.mutex.RLock()
+	defer receiver.mutex.RUnlock()
+
+	for _, route := range receiver.handlers {
+		if matched, _ := path.Match(route.pattern, event); matched {
+			handlers = append(handlers, route.handler)
+		}
+	}
+	return
+}
+
+// ParseProjectNotifications : Parse the CloudEvents of a webhook request
+// The body is a CloudEvent in the structured content mode, a batch of them, or the data of a CloudEvent in the binary
+// content mode, whose attributes are the ce-* headers. The project, configuration and version of each notification are
+// read from the project_id, config_id and config_version properties of the data, or from the id and version
+// properties of its project and config objects. Otherwise they are read from a project or configuration URL in the
+// subject, the source or the href property of the data.
+func ParseProjectNotifications(header http.Header, body []byte) (notifications []*ProjectNotification, err error) {
+	mediaType, _, _ := mime.ParseMediaType(header.Get("Content-Type"))
+	switch {
+	case mediaType == "application/cloudevents-batch+json":
+		if err = json.Unmarshal(body, &notifications); err != nil {
+			err = core.SDKErrorf(err, "the notifications are not a batch of CloudEvents", "notification-parse-error", common.GetComponentInfo())
+			return
+		}
+	case header.Get("Ce-Specversion") != "":
+		notification := &ProjectNotification{
+			ID:               header.Get("Ce-Id"),
+			Source:           header.Get("Ce-Source"),
+			SpecVersion:      header.Get("Ce-Specversion"),
+			Type:             header.Get("Ce-Type"),
+			Subject:          header.Get("Ce-Subject"),
+			SourceID:         header.Get("Ce-Ibmensourceid"),
+			Severity:         header.Get("Ce-Ibmenseverity"),
+			ShortDescription: header.Get("Ce-Ibmendefaultshort"),
+			LongDescription:  header.Get("Ce-Ibmendefaultlong"),
+		}
+		if value := header.Get("Ce-Time"); value != "" {
+			var timestamp strfmt.DateTime
+			if timestamp, err = strfmt.ParseDateTime(value); err != nil {
+				err = core.SDKErrorf(err, "the time of the notification is not valid", "notification-parse-error", common.GetComponentInfo())
+				return
+			}
+			notification.Time = &timestamp
+		}
+		if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 {
+			if !json.Valid(trimmed) {
+				err = core.SDKErrorf(nil, "the data of the notification is not JSON", "notification-parse-error", common.GetComponentInfo())
+				return
+			}
+			notification.Data = json.RawMessage(trimmed)
+		}
+		notifications = []*ProjectNotification{notification}
+	default:
+		notification := new(ProjectNotification)
+		if err = json.Unmarshal(body, notification); err != nil {
+			err = core.SDKErrorf(err, "the notification is not a CloudEvent", "notification-parse-error", common.GetComponentInfo())
+			return
+		}
+		notifications = []*ProjectNotification{notification}
+	}
+
+	for _, notification := range notifications {
+		if notification == nil || notification.ID == "" || notification.Type == "" || notification.SpecVersion == "" {
+			err = core.SDKErrorf(nil, "the notification is not a CloudEvent: it must have an id, a type and a specversion", "notification-parse-error", common.GetComponentInfo())
+			return
+		}
+		notification.correlate()
+	}
+	return
+}
+
+// notificationURLPattern matches the URLs of projects and configurations, such as
+// "/v1/projects/{project_id}/configs/{id}/versions/{version}".
+var notificationURLPattern = regexp.MustCompile(`/projects/([^/?#]+)(?:/configs/([^/?#]+)(?:/versions/(\d+))?)?`)
+
+// correlate sets the event, project, configuration and version of the notification from its data and attributes.
+func (notification *ProjectNotification) correlate() {
+	var data struct {
+		Event         string      `json:"event"`
+		ProjectID     string      `json:"project_id"`
+		ConfigID      string      `json:"config_id"`
+		ConfigVersion json.Number `json:"config_version"`
+		Href          string      `json:"href"`
+		Project       *struct {
+			ID string `json:"id"`
+		} `json:"project"`
+		Config *struct {
+			ID      string      `json:"id"`
+			Version json.Number `json:"version"`
+		} `json:"config"`
+	}
+	// The data may be any JSON value, so the properties are best effort.
+	_ = json.Unmarshal(notification.Data, &data)
+
+	notification.Event = data.Event
+	if notification.Event == "" {
+		notification.Event = notification.Type
+	}
+	notification.ProjectID = data.ProjectID
+	if notification.ProjectID == "" && data.Project != nil {
+		notification.ProjectID = data.Project.ID
+	}
+	notification.ConfigID = data.ConfigID
+	version := data.ConfigVersion
+	if notification.ConfigID == "" && data.Config != nil {
+		notification.ConfigID = data.Config.ID
+		version = data.Config.Version
+	}
+	notification.ConfigVersion, _ = strconv.ParseInt(version.String(), 10, 64)
+
+	for _, url := range []string{notification.Subject, notification.Source, data.Href} {
+		match := notificationURLPattern.FindStringSubmatch(url)
+		if match == nil || (notification.ProjectID != "" && match[1] != notification.ProjectID) {
+			continue
+		}
+		notification.ProjectID = match[1]
+		if notification.ConfigID == "" && match[2] != "" {
+			notification.ConfigID = match[2]
+			notification.ConfigVersion, _ = strconv.ParseInt(match[3], 10, 64)
+		}
+	}
+}
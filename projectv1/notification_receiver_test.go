@@ -0,0 +1,181 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/IBM/project-go-sdk/projectv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`NotificationReceiver`, func() {
+	const secret = "s3cr3t"
+	const driftEvent = `{
+		"specversion": "1.0",
+		"id": "notification-1",
+		"source": "crn:v1:bluemix:public:project:global:a/123::project:project-1",
+		"type": "com.ibm.cloud.project.config",
+		"time": "2026-03-01T09:00:00Z",
+		"ibmensourceid": "source-1",
+		"ibmenseverity": "WARNING",
+		"ibmendefaultshort": "Drift detected",
+		"ibmendefaultlong": "Drift was detected on configuration network.",
+		"datacontenttype": "application/json",
+		"data": {
+			"event": "project.config.drift_detected",
+			"project_id": "project-1",
+			"config_id": "config-1",
+			"config_version": 3,
+			"resources": ["ibm_is_vpc.vpc"]
+		}
+	}`
+
+	var receiver *projectv1.NotificationReceiver
+	var received []string
+
+	post := func(body string, header http.Header) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/notifications", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/cloudevents+json")
+		req.Header.Set("X-Notification-Secret", secret)
+		for name, values := range header {
+			req.Header[name] = values
+		}
+		recorder := httptest.NewRecorder()
+		receiver.ServeHTTP(recorder, req)
+		return recorder
+	}
+	record := func(name string) func(context.Context, *projectv1.ProjectNotification) error {
+		return func(ctx context.Context, notification *projectv1.ProjectNotification) error {
+			received = append(received, name+" "+notification.Event)
+			return nil
+		}
+	}
+
+	BeforeEach(func() {
+		var err error
+		receiver, err = projectv1.NewNotificationReceiver(projectv1.SharedSecretVerifier("X-Notification-Secret", secret))
+		Expect(err).To(BeNil())
+		received = nil
+	})
+
+	It(`Parses a structured notification and dispatches it to the matching handlers`, func() {
+		var notification *projectv1.ProjectNotification
+		receiver.HandleFunc("project.config.*", func(ctx context.Context, n *projectv1.ProjectNotification) error {
+			notification = n
+			return nil
+		})
+		receiver.HandleFunc("*", record("all"))
+		receiver.HandleFunc("project.delete", record("delete"))
+
+		recorder := post(driftEvent, nil)
+		Expect(recorder.Code).To(Equal(http.StatusNoContent))
+		Expect(received).To(Equal([]string{"all project.config.drift_detected"}))
+		Expect(notification.ID).To(Equal("notification-1"))
+		Expect(notification.SpecVersion).To(Equal("1.0"))
+		Expect(notification.Type).To(Equal("com.ibm.cloud.project.config"))
+		Expect(time.Time(*notification.Time).Equal(time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC))).To(BeTrue())
+		Expect(notification.SourceID).To(Equal("source-1"))
+		Expect(notification.Severity).To(Equal("WARNING"))
+		Expect(notification.ShortDescription).To(Equal("Drift detected"))
+		Expect(notification.LongDescription).To(Equal("Drift was detected on configuration network."))
+		Expect(notification.Event).To(Equal("project.config.drift_detected"))
+		Expect(notification.ProjectID).To(Equal("project-1"))
+		Expect(notification.ConfigID).To(Equal("config-1"))
+		Expect(notification.ConfigVersion).To(Equal(int64(3)))
+
+		var data struct {
+			Resources []string `json:"resources"`
+		}
+		Expect(notification.DecodeData(&data)).To(BeNil())
+		Expect(data.Resources).To(Equal([]string{"ibm_is_vpc.vpc"}))
+	})
+	It(`Parses binary and batched notifications`, func() {
+		notifications, err := projectv1.ParseProjectNotifications(http.Header{
+			"Content-Type":     {"application/json"},
+			"Ce-Specversion":   {"1.0"},
+			"Ce-Id":            {"notification-2"},
+			"Ce-Source":        {"projects"},
+			"Ce-Type":          {"com.ibm.cloud.project.config.deploy_failed"},
+			"Ce-Time":          {"2026-03-01T10:00:00Z"},
+			"Ce-Ibmenseverity": {"ERROR"},
+		}, []byte(`{"href": "https://projects.api.cloud.ibm.com/v1/projects/project-2/configs/config-2/versions/4"}`))
+		Expect(err).To(BeNil())
+		Expect(notifications).To(HaveLen(1))
+		Expect(notifications[0].ID).To(Equal("notification-2"))
+		Expect(notifications[0].Severity).To(Equal("ERROR"))
+		Expect(notifications[0].Time).ToNot(BeNil())
+		Expect(notifications[0].Event).To(Equal("com.ibm.cloud.project.config.deploy_failed"))
+		Expect(notifications[0].ProjectID).To(Equal("project-2"))
+		Expect(notifications[0].ConfigID).To(Equal("config-2"))
+		Expect(notifications[0].ConfigVersion).To(Equal(int64(4)))
+
+		notifications, err = projectv1.ParseProjectNotifications(http.Header{
+			"Content-Type": {"application/cloudevents-batch+json; charset=utf-8"},
+		}, []byte(`[`+driftEvent+`, {"specversion": "1.0", "id": "notification-3", "type": "project.delete",
+			"source": "projects", "subject": "/v1/projects/project-3", "data": {"project": {"id": "project-3"}}}]`))
+		Expect(err).To(BeNil())
+		Expect(notifications).To(HaveLen(2))
+		Expect(notifications[0].ConfigID).To(Equal("config-1"))
+		Expect(notifications[1].Event).To(Equal("project.delete"))
+		Expect(notifications[1].ProjectID).To(Equal("project-3"))
+		Expect(notifications[1].ConfigID).To(BeEmpty())
+	})
+	It(`Rejects the requests that are not verified CloudEvents`, func() {
+		receiver.HandleFunc("*", record("all"))
+
+		Expect(post(driftEvent, http.Header{"X-Notification-Secret": {"wrong"}}).Code).To(Equal(http.StatusUnauthorized))
+		Expect(post(`{"id": "notification-1"}`, nil).Code).To(Equal(http.StatusBadRequest))
+		Expect(post(`not json`, nil).Code).To(Equal(http.StatusBadRequest))
+
+		recorder := httptest.NewRecorder()
+		receiver.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/notifications", nil))
+		Expect(recorder.Code).To(Equal(http.StatusMethodNotAllowed))
+		Expect(recorder.Header().Get("Allow")).To(Equal(http.MethodPost))
+
+		receiver.MaxBodyBytes = 64
+		Expect(post(driftEvent, nil).Code).To(Equal(http.StatusRequestEntityTooLarge))
+		Expect(received).To(BeEmpty())
+	})
+	It(`Replies with a server error when a handler fails`, func() {
+		receiver.HandleFunc("*", func(ctx context.Context, notification *projectv1.ProjectNotification) error {
+			return errors.New("boom")
+		})
+		receiver.HandleFunc("*", record("all"))
+
+		Expect(post(driftEvent, nil).Code).To(Equal(http.StatusInternalServerError))
+		Expect(received).To(Equal([]string{"all project.config.drift_detected"}))
+	})
+	It(`Accepts the notifications that no handler matches`, func() {
+		receiver.HandleFunc("project.delete", record("delete"))
+		Expect(post(driftEvent, nil).Code).To(Equal(http.StatusNoContent))
+		Expect(received).To(BeEmpty())
+	})
+	It(`Invoke NewNotificationReceiver with error: Param validation error`, func() {
+		_, err := projectv1.NewNotificationReceiver(nil)
+		Expect(err).ToNot(BeNil())
+
+		notification := &projectv1.ProjectNotification{}
+		Expect(notification.DecodeData(&struct{}{})).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,174 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/project-go-sdk/common"
+)
+
+// ErrOutputNotFound is the cause of the errors returned for an output or a reference that a configuration does not
+// have. Check for it with errors.Is.
+var ErrOutputNotFound = errors.New("value not found")
+
+// ErrSensitiveOutput is the cause of the errors that OutputAs, ReferenceAs and DecodeOutputs return for a sensitive
+// output or reference. Read a sensitive output with SensitiveOutputAs, and a sensitive reference with
+// SensitiveReferenceAs.
+var ErrSensitiveOutput = errors.New("output is sensitive")
+
+// OutputAs : Get the value of an output of a configuration as a T
+// The value is converted through its JSON encoding, so numbers can be read as any numeric type, lists as slices and
+// objects as maps or as structs with json tags. An error is returned if the configuration has no output with the name,
+// if the value cannot be converted to a T, or if the output is sensitive.
+func OutputAs[T any](config *ProjectConfig, name string) (result T, err error) {
+	output, err := findOutput(config, name)
+	if err != nil {
+		return
+	}
+	if output.Sensitive != nil && *output.Sensitive {
+		err = core.SDKErrorf(ErrSensitiveOutput, fmt.Sprintf("output '%s' is sensitive; read it with SensitiveOutputAs", name),
+			"sensitive-output", common.GetComponentInfo())
+		return
+	}
+	return convertValue[T](output.Value, "output", name)
+}
+
+// SensitiveOutputAs : Get the value of an output of a configuration as a T, even if it is sensitive
+// It is OutputAs for the outputs whose values are secrets: the caller is responsible for not logging the value.
+func SensitiveOutputAs[T any](config *ProjectConfig, name string) (result T, err error) {
+	output, err := findOutput(config, name)
+	if err != nil {
+		return
+	}
+	return convertValue[T](output.Value, "output", name)
+}
+
+// ReferenceAs : Get the resolved value of the reference of an input of a configuration as a T
+// The input must hold a reference, such as "ref:../configs/network/outputs/vpc_id", and the value is the one that the
+// service resolved the reference to, in the references of the configuration. The value is converted like in OutputAs.
+// An error is returned if the input does not hold a reference, if the service has not resolved it, if the value
+// cannot be converted to a T, or if the value is sensitive.
+func ReferenceAs[T any](config *ProjectConfig, input string) (result T, err error) {
+	value, sensitive, err := findReference(config, input)
+	if err != nil {
+		return
+	}
+	if sensitive {
+		err = core.SDKErrorf(ErrSensitiveOutput, fmt.Sprintf("the reference of input '%s' is sensitive; read it with SensitiveReferenceAs", input),
+			"sensitive-reference", common.GetComponentInfo())
+		return
+	}
+	return convertValue[T](value, "reference", input)
+}
+
+// SensitiveReferenceAs : Get the resolved value of the reference of an input of a configuration as a T, even if it is
+// sensitive
+// It is ReferenceAs for the references whose values are secrets: the caller is responsible for not logging the value.
+func SensitiveReferenceAs[T any](config *ProjectConfig, input string) (result T, err error) {
+	value, _, err := findReference(config, input)
+	if err != nil {
+		return
+	}
+	return convertValue[T](value, "reference", input)
+}
+
+// DecodeOutputs : Decode the outputs of a configuration into a struct
+// The outputs are decoded like a JSON object with a property per output, so the fields of the struct are matched by
+// their json tags. Sensitive outputs are left out unless includeSensitive is true; fields whose outputs are left out
+// or missing keep their values.
+func DecodeOutputs(config *ProjectConfig, result interface{}, includeSensitive bool) error {
+	values := make(map[string]interface{})
+	if config != nil {
+		for _, output := range config.Outputs {
+			if output.Name == nil || (!includeSensitive && output.Sensitive != nil && *output.Sensitive) {
+				continue
+			}
+			values[*output.Name] = output.Value
+		}
+	}
+	data, err := json.Marshal(values)
+	if err != nil {
+		return core.SDKErrorf(err, "", "outputs-marshal-error", common.GetComponentInfo())
+	}
+	err = json.Unmarshal(data, result)
+	if err != nil {
+		return core.SDKErrorf(err, fmt.Sprintf("the outputs of configuration '%s' cannot be decoded: %s", configIDOf(config), err.Error()),
+			"outputs-decode-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+func findOutput(config *ProjectConfig, name string) (*OutputValue, error) {
+	if config != nil {
+		for i := range config.Outputs {
+			if core.StringNilMapper(config.Outputs[i].Name) == name {
+				return &config.Outputs[i], nil
+			}
+		}
+	}
+	return nil, core.SDKErrorf(ErrOutputNotFound, fmt.Sprintf("configuration '%s' has no output '%s'", configIDOf(config), name),
+		"output-not-found", common.GetComponentInfo())
+}
+
+// findReference returns the value that the service resolved the reference of an input of a configuration to, and
+// whether it is sensitive.
+func findReference(config *ProjectConfig, input string) (value interface{}, sensitive bool, err error) {
+	var expression string
+	if config != nil {
+		if definition := definitionResponse(config.Definition); definition != nil {
+			expression, _ = definition.Inputs[input].(string)
+		}
+	}
+	if !IsInputReference(expression) {
+		err = core.SDKErrorf(ErrOutputNotFound, fmt.Sprintf("input '%s' of configuration '%s' is not a reference", input, configIDOf(config)),
+			"reference-not-found", common.GetComponentInfo())
+		return
+	}
+	value, sensitive, found := referencedValue(config, expression)
+	if !found {
+		err = core.SDKErrorf(ErrOutputNotFound, fmt.Sprintf("reference '%s' of configuration '%s' is not resolved", expression, configIDOf(config)),
+			"reference-not-found", common.GetComponentInfo())
+	}
+	return
+}
+
+// convertValue converts a decoded JSON value to a T.
+func convertValue[T any](value interface{}, kind string, name string) (result T, err error) {
+	if converted, ok := value.(T); ok {
+		return converted, nil
+	}
+	data, err := json.Marshal(value)
+	if err == nil {
+		err = json.Unmarshal(data, &result)
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("the value of %s '%s' cannot be converted to %T: %s", kind, name, result, err.Error()),
+			"value-conversion-error", common.GetComponentInfo())
+	}
+	return
+}
+
+func configIDOf(config *ProjectConfig) string {
+	if config == nil {
+		return ""
+	}
+	return core.StringNilMapper(config.ID)
+}
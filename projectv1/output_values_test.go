@@ -0,0 +1,175 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/project-go-sdk/projectv1"
+	"github.com/IBM/project-go-sdk/projectv1fake"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Output values`, func() {
+	var config *projectv1.ProjectConfig
+
+	BeforeEach(func() {
+		var raw map[string]json.RawMessage
+		Expect(json.Unmarshal([]byte(`{
+			"id": "config-id",
+			"outputs": [
+				{"name": "vpc_id", "value": "r006-1234"},
+				{"name": "subnet_count", "value": 3},
+				{"name": "zones", "value": ["us-south-1", "us-south-2"]},
+				{"name": "cluster", "value": {"name": "my-cluster", "workers": 6}},
+				{"name": "admin_key", "value": "secret-key", "sensitive": true}
+			],
+			"definition": {
+				"name": "app",
+				"inputs": {
+					"region": "ref:../environments/dev/inputs/region",
+					"cidrs": "ref:../configs/network/outputs/cidrs",
+					"api_key": "ref:../environments/dev/authorizations/api_key",
+					"pending": "ref:../configs/network/outputs/pending",
+					"name": "app"
+				}
+			},
+			"references": {
+				"ref:../environments/dev/inputs/region": {"value": "us-south"},
+				"ref:../configs/network/outputs/cidrs": {"value": ["10.0.0.0/24"], "sensitive": false},
+				"ref:../environments/dev/authorizations/api_key": {"value": "secret-api-key", "sensitive": true}
+			}
+		}`), &raw)).To(Succeed())
+		Expect(core.UnmarshalModel(raw, "", &config, projectv1.UnmarshalProjectConfig)).To(Succeed())
+	})
+
+	It(`Converts outputs to typed values`, func() {
+		vpcID, err := projectv1.OutputAs[string](config, "vpc_id")
+		Expect(err).To(BeNil())
+		Expect(vpcID).To(Equal("r006-1234"))
+
+		count, err := projectv1.OutputAs[int](config, "subnet_count")
+		Expect(err).To(BeNil())
+		Expect(count).To(Equal(3))
+
+		zones, err := projectv1.OutputAs[[]string](config, "zones")
+		Expect(err).To(BeNil())
+		Expect(zones).To(Equal([]string{"us-south-1", "us-south-2"}))
+
+		type cluster struct {
+			Name    string `json:"name"`
+			Workers int    `json:"workers"`
+		}
+		value, err := projectv1.OutputAs[cluster](config, "cluster")
+		Expect(err).To(BeNil())
+		Expect(value).To(Equal(cluster{Name: "my-cluster", Workers: 6}))
+		object, err := projectv1.OutputAs[map[string]interface{}](config, "cluster")
+		Expect(err).To(BeNil())
+		Expect(object["workers"]).To(Equal(float64(6)))
+	})
+	It(`Returns errors for missing, sensitive and mistyped outputs`, func() {
+		_, err := projectv1.OutputAs[string](config, "missing")
+		Expect(errors.Is(err, projectv1.ErrOutputNotFound)).To(BeTrue())
+		Expect(err.Error()).To(Equal("configuration 'config-id' has no output 'missing'"))
+
+		_, err = projectv1.OutputAs[string](config, "admin_key")
+		Expect(errors.Is(err, projectv1.ErrSensitiveOutput)).To(BeTrue())
+		key, err := projectv1.SensitiveOutputAs[string](config, "admin_key")
+		Expect(err).To(BeNil())
+		Expect(key).To(Equal("secret-key"))
+
+		_, err = projectv1.OutputAs[int](config, "vpc_id")
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("cannot be converted to int"))
+
+		_, err = projectv1.OutputAs[string](nil, "vpc_id")
+		Expect(errors.Is(err, projectv1.ErrOutputNotFound)).To(BeTrue())
+	})
+	It(`Converts references to typed values`, func() {
+		region, err := projectv1.ReferenceAs[string](config, "region")
+		Expect(err).To(BeNil())
+		Expect(region).To(Equal("us-south"))
+		cidrs, err := projectv1.ReferenceAs[[]string](config, "cidrs")
+		Expect(err).To(BeNil())
+		Expect(cidrs).To(Equal([]string{"10.0.0.0/24"}))
+
+		_, err = projectv1.ReferenceAs[string](config, "missing")
+		Expect(errors.Is(err, projectv1.ErrOutputNotFound)).To(BeTrue())
+		_, err = projectv1.ReferenceAs[string](config, "name")
+		Expect(errors.Is(err, projectv1.ErrOutputNotFound)).To(BeTrue())
+		Expect(err.Error()).To(Equal("input 'name' of configuration 'config-id' is not a reference"))
+		_, err = projectv1.ReferenceAs[string](config, "pending")
+		Expect(errors.Is(err, projectv1.ErrOutputNotFound)).To(BeTrue())
+		Expect(err.Error()).To(Equal("reference 'ref:../configs/network/outputs/pending' of configuration 'config-id' is not resolved"))
+	})
+	It(`Does not return sensitive references unless asked to`, func() {
+		_, err := projectv1.ReferenceAs[string](config, "api_key")
+		Expect(errors.Is(err, projectv1.ErrSensitiveOutput)).To(BeTrue())
+		Expect(err.Error()).ToNot(ContainSubstring("secret-api-key"))
+		key, err := projectv1.SensitiveReferenceAs[string](config, "api_key")
+		Expect(err).To(BeNil())
+		Expect(key).To(Equal("secret-api-key"))
+	})
+	It(`Reads the references that the server resolved`, func() {
+		server := projectv1fake.NewServer()
+		defer server.Close()
+		projectService, err := server.NewProjectV1()
+		Expect(err).To(BeNil())
+		project, _, err := projectService.CreateProject(projectService.NewCreateProjectOptions(
+			&projectv1.ProjectPrototypeDefinition{Name: core.StringPtr("my-project")}, "us-south", "Default"))
+		Expect(err).To(BeNil())
+		created, _, err := projectService.CreateConfig(projectService.NewCreateConfigOptions(*project.ID,
+			&projectv1.ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype{
+				Name:   core.StringPtr("app"),
+				Inputs: map[string]interface{}{"subnet_count": "ref:../configs/network/outputs/subnet_count"},
+			}))
+		Expect(err).To(BeNil())
+		references := &projectv1.ReferenceValue{}
+		references.SetProperty("ref:../configs/network/outputs/subnet_count", map[string]interface{}{"value": 3, "sensitive": false})
+		server.SetReferences(*created.ID, references)
+
+		live, _, err := projectService.GetConfig(projectService.NewGetConfigOptions(*project.ID, *created.ID))
+		Expect(err).To(BeNil())
+		count, err := projectv1.ReferenceAs[int](live, "subnet_count")
+		Expect(err).To(BeNil())
+		Expect(count).To(Equal(3))
+	})
+	It(`Decodes outputs into a struct`, func() {
+		var outputs struct {
+			VpcID       string   `json:"vpc_id"`
+			SubnetCount int64    `json:"subnet_count"`
+			Zones       []string `json:"zones"`
+			AdminKey    string   `json:"admin_key"`
+		}
+		Expect(projectv1.DecodeOutputs(config, &outputs, false)).To(Succeed())
+		Expect(outputs.VpcID).To(Equal("r006-1234"))
+		Expect(outputs.SubnetCount).To(Equal(int64(3)))
+		Expect(outputs.Zones).To(HaveLen(2))
+		Expect(outputs.AdminKey).To(BeEmpty())
+
+		Expect(projectv1.DecodeOutputs(config, &outputs, true)).To(Succeed())
+		Expect(outputs.AdminKey).To(Equal("secret-key"))
+
+		var mistyped struct {
+			VpcID int `json:"vpc_id"`
+		}
+		Expect(projectv1.DecodeOutputs(config, &mistyped, false)).ToNot(Succeed())
+	})
+})
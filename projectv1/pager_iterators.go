@@ -0,0 +1,72 @@
+//go:build go1.23
+
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1
+
+import (
+	"context"
+	"iter"
+)
+
+// All returns an iterator over the remaining results, which retrieves each page only when the iteration reaches it.
+// Ending the iteration early leaves the pager positioned after the last retrieved page.
+func (pager *ProjectsPager) All(ctx context.Context) iter.Seq2[ProjectSummary, error] {
+	return pagerItems(pager.HasNext, func() ([]ProjectSummary, error) {
+		return pager.GetNextWithContext(ctx)
+	})
+}
+
+// All returns an iterator over the remaining results, which retrieves each page only when the iteration reaches it.
+// Ending the iteration early leaves the pager positioned after the last retrieved page.
+func (pager *ProjectEnvironmentsPager) All(ctx context.Context) iter.Seq2[Environment, error] {
+	return pagerItems(pager.HasNext, func() ([]Environment, error) {
+		return pager.GetNextWithContext(ctx)
+	})
+}
+
+// All returns an iterator over the remaining results, which retrieves each page only when the iteration reaches it.
+// Ending the iteration early leaves the pager positioned after the last retrieved page.
+func (pager *ConfigsPager) All(ctx context.Context) iter.Seq2[ProjectConfigSummary, error] {
+	return pagerItems(pager.HasNext, func() ([]ProjectConfigSummary, error) {
+		return pager.GetNextWithContext(ctx)
+	})
+}
+
+// All returns an iterator over the remaining results, which retrieves each page only when the iteration reaches it.
+// Ending the iteration early leaves the pager positioned after the last retrieved page.
+func (pager *ConfigVersionsPager) All(ctx context.Context) iter.Seq2[ProjectConfigVersionSummary, error] {
+	return pagerItems(pager.HasNext, func() ([]ProjectConfigVersionSummary, error) {
+		return pager.GetNextWithContext(ctx)
+	})
+}
+
+// All returns an iterator over the remaining results, which retrieves each page only when the iteration reaches it.
+// Ending the iteration early leaves the pager positioned after the last retrieved page.
+func (pager *ConfigResourcesPager) All(ctx context.Context) iter.Seq2[ProjectConfigResource, error] {
+	return pagerItems(pager.HasNext, func() ([]ProjectConfigResource, error) {
+		return pager.GetNextWithContext(ctx)
+	})
+}
+
+// All returns an iterator over the remaining results, which retrieves each page only when the iteration reaches it.
+// Ending the iteration early leaves the pager positioned after the last retrieved page.
+func (pager *StackMembersPager) All(ctx context.Context) iter.Seq2[ProjectConfig, error] {
+	return pagerItems(pager.HasNext, func() ([]ProjectConfig, error) {
+		return pager.GetNextWithContext(ctx)
+	})
+}
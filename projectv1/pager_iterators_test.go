@@ -0,0 +1,165 @@
+//go:build go1.23
+
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/project-go-sdk/projectv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Pager iterators`, func() {
+	var testServer *httptest.Server
+	var projectService *projectv1.ProjectV1
+	var requestNumber int
+
+	// servePages serves two pages of two items each for the specified path, and fails any further request.
+	servePages := func(path string, itemsKey string, item string) {
+		requestNumber = 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.URL.EscapedPath()).To(Equal(path))
+			Expect(req.Method).To(Equal("GET"))
+			res.Header().Set("Content-type", "application/json")
+			requestNumber++
+			switch requestNumber {
+			case 1:
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"next": {"href": "https://myhost.com/somePath?token=1"}, "%s": [%s, %s]}`, itemsKey, item, item)
+			case 2:
+				Expect(req.URL.Query().Get("token")).To(Equal("1"))
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"%s": [%s, %s]}`, itemsKey, item, item)
+			default:
+				res.WriteHeader(400)
+			}
+		}))
+		var serviceErr error
+		projectService, serviceErr = projectv1.NewProjectV1(&projectv1.ProjectV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	}
+
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Use ProjectsPager.All successfully`, func() {
+		servePages("/v1/projects", "projects", `{"id": "ID"}`)
+
+		pager, err := projectService.NewProjectsPager(&projectv1.ListProjectsOptions{})
+		Expect(err).To(BeNil())
+
+		var allResults []projectv1.ProjectSummary
+		for project, err := range pager.All(context.Background()) {
+			Expect(err).To(BeNil())
+			allResults = append(allResults, project)
+		}
+		Expect(allResults).To(HaveLen(4))
+		Expect(*allResults[3].ID).To(Equal("ID"))
+		Expect(pager.HasNext()).To(BeFalse())
+		Expect(requestNumber).To(Equal(2))
+	})
+	It(`Use ProjectEnvironmentsPager.All successfully`, func() {
+		servePages("/v1/projects/testString/environments", "environments", `{"id": "ID"}`)
+
+		pager, err := projectService.NewProjectEnvironmentsPager(projectService.NewListProjectEnvironmentsOptions("testString"))
+		Expect(err).To(BeNil())
+
+		count := 0
+		for environment, err := range pager.All(context.Background()) {
+			Expect(err).To(BeNil())
+			Expect(*environment.ID).To(Equal("ID"))
+			count++
+		}
+		Expect(count).To(Equal(4))
+	})
+	It(`Use ConfigsPager.All successfully`, func() {
+		servePages("/v1/projects/testString/configs", "configs", `{"id": "ID"}`)
+
+		pager, err := projectService.NewConfigsPager(projectService.NewListConfigsOptions("testString"))
+		Expect(err).To(BeNil())
+
+		count := 0
+		for config, err := range pager.All(context.Background()) {
+			Expect(err).To(BeNil())
+			Expect(*config.ID).To(Equal("ID"))
+			count++
+		}
+		Expect(count).To(Equal(4))
+	})
+	It(`Use ConfigVersionsPager.All successfully`, func() {
+		servePages("/v1/projects/testString/configs/testString/versions", "versions", `{"version": 1}`)
+
+		pager, err := projectService.NewConfigVersionsPager(projectService.NewListConfigVersionsOptions("testString", "testString"))
+		Expect(err).To(BeNil())
+
+		count := 0
+		for version, err := range pager.All(context.Background()) {
+			Expect(err).To(BeNil())
+			Expect(*version.Version).To(Equal(int64(1)))
+			count++
+		}
+		Expect(count).To(Equal(4))
+	})
+	It(`Stops fetching pages when the iteration ends early`, func() {
+		servePages("/v1/projects/testString/configs", "configs", `{"id": "ID"}`)
+
+		pager, err := projectService.NewConfigsPager(projectService.NewListConfigsOptions("testString"))
+		Expect(err).To(BeNil())
+
+		count := 0
+		for _, err := range pager.All(context.Background()) {
+			Expect(err).To(BeNil())
+			count++
+			if count == 2 {
+				break
+			}
+		}
+		Expect(count).To(Equal(2))
+		Expect(requestNumber).To(Equal(1))
+		Expect(pager.HasNext()).To(BeTrue())
+	})
+	It(`Yields the error when a page cannot be retrieved`, func() {
+		servePages("/v1/projects", "projects", `{"id": "ID"}`)
+
+		pager, err := projectService.NewProjectsPager(&projectv1.ListProjectsOptions{})
+		Expect(err).To(BeNil())
+		_, err = pager.GetNext()
+		Expect(err).To(BeNil())
+		requestNumber = 2
+
+		var errs []error
+		for project, err := range pager.All(context.Background()) {
+			Expect(project.ID).To(BeNil())
+			errs = append(errs, err)
+		}
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0]).ToNot(BeNil())
+	})
+})
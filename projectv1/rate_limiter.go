@@ -0,0 +1,339 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// DefaultThrottleBackoff is how long the rate limiter of SetRateLimit pauses the requests after a 429 Too Many
+// Requests response that has no Retry-After header.
+const DefaultThrottleBackoff = time.Second
+
+const (
+	// throttleRateFactor is the factor by which a 429 response lowers the rate of the limiter.
+	throttleRateFactor = 0.5
+
+	// throttleMinRateFactor is the share of the configured rate below which 429 responses do not lower the rate.
+	throttleMinRateFactor = 0.1
+
+	// throttleRecoveryPeriod is how long the rate of the limiter takes to grow from zero back to the configured rate
+	// when no 429 response is received.
+	throttleRecoveryPeriod = 30 * time.Second
+)
+
+// ThrottleStats : The state of the rate limiter of a ProjectV1 client.
+type ThrottleStats struct {
+	// The configured number of requests per second.
+	RequestsPerSecond float64 `json:"requests_per_second"`
+
+	// The configured number of requests that can be sent at once.
+	Burst int `json:"burst"`
+
+	// The number of requests per second that are currently allowed. It is lower than RequestsPerSecond after 429
+	// responses, and grows back to it over time.
+	CurrentRate float64 `json:"current_rate"`
+
+	// The number of requests that can be sent now without waiting. It is negative when requests are waiting.
+	AvailableTokens float64 `json:"available_tokens"`
+
+	// The number of requests that went through the limiter.
+	Requests int64 `json:"requests"`
+
+	// The number of requests that had to wait.
+	DelayedRequests int64 `json:"delayed_requests"`
+
+	// The total time that requests waited.
+	TotalDelay time.Duration `json:"total_delay"`
+
+	// The number of 429 Too Many Requests responses.
+	ThrottledResponses int64 `json:"throttled_responses"`
+
+	// The delay that the last 429 response asked for with its Retry-After header, or DefaultThrottleBackoff.
+	LastRetryAfter time.Duration `json:"last_retry_after,omitempty"`
+
+	// The time until which requests are paused after a 429 response. It is nil when requests are not paused.
+	PausedUntil *strfmt.DateTime `json:"paused_until,omitempty"`
+}
+
+// SetRateLimit : Limit the rate of the requests of the client
+// Every request of the client, including those of the *WithContext methods, of the helpers that call them and of
+// their retries, takes a token from a bucket that holds up to burst tokens and is refilled at requestsPerSecond. A
+// request that finds the bucket empty waits for a token, or until its context is done.
+//
+// When the service replies 429 Too Many Requests, the requests are paused for the delay of its Retry-After header, or
+// DefaultThrottleBackoff, and the rate is halved, down to a tenth of requestsPerSecond. The rate grows back to
+// requestsPerSecond over 30 seconds without 429 responses. The 429 response is still returned to the caller, so enable
+// retries with EnableRetries to send the request again.
+//
+// Setting a rate limit replaces the previous one, and a requestsPerSecond of zero removes it. A burst lower than 1 is
+// replaced by 1.
+//
+// The limiter is installed around the transport of the HTTP client of the service. Transports that are installed
+// around it later, such as a projectv1cassette.Transport, must have an Unwrap() http.RoundTripper method that returns
+// the transport that they wrap, so that SetRateLimit and GetThrottleStats find the limiter behind them. Call
+// EnableRetries before SetRateLimit, since the retrying client does not unwrap.
+func (project *ProjectV1) SetRateLimit(requestsPerSecond float64, burst int) {
+	var bucket *tokenBucket
+	if requestsPerSecond > 0 {
+		bucket = newTokenBucket(requestsPerSecond, burst)
+	}
+	if limiter := findRateLimitTransport(project.Service.GetHTTPClient().Transport); limiter != nil {
+		limiter.setBucket(bucket)
+		return
+	}
+	if bucket == nil {
+		return
+	}
+	client := *project.Service.GetHTTPClient()
+	client.Transport = &rateLimitTransport{bucket: bucket, next: client.Transport}
+	project.Service.SetHTTPClient(&client)
+}
+
+// GetThrottleStats : Return the state of the rate limiter of the client
+// Nil is returned if no rate limit is set.
+func (project *ProjectV1) GetThrottleStats() *ThrottleStats {
+	if limiter := findRateLimitTransport(project.Service.GetHTTPClient().Transport); limiter != nil {
+		if bucket := limiter.getBucket(); bucket != nil {
+			return bucket.stats(time.Now())
+		}
+	}
+	return nil
+}
+
+// findRateLimitTransport returns the rate limiter in a chain of transports, following the transports that have an
+// Unwrap method. It returns nil if there is none.
+func findRateLimitTransport(transport http.RoundTripper) *rateLimitTransport {
+	for transport != nil {
+		if limiter, ok := transport.(*rateLimitTransport); ok {
+			return limiter
+		}
+		wrapper, ok := transport.(interface{ Unwrap() http.RoundTripper })
+		if !ok {
+			return nil
+		}
+		transport = wrapper.Unwrap()
+	}
+	return nil
+}
+
+// rateLimitTransport is an http.RoundTripper that waits for a token before it sends a request. Its bucket is replaced
+// in place, so that a limiter that other transports wrap is never installed twice; a nil bucket does not limit the
+// rate.
+type rateLimitTransport struct {
+	mutex  sync.Mutex
+	bucket *tokenBucket
+	next   http.RoundTripper
+}
+
+func (transport *rateLimitTransport) getBucket() *tokenBucket {
+	transport.mutex.Lock()
+	defer transport.mutex.Unlock()
+	return transport.bucket
+}
+
+func (transport *rateLimitTransport) setBucket(bucket *tokenBucket) {
+	transport.mutex.Lock()
+	defer transport.mutex.Unlock()
+	transport.bucket = bucket
+}
+
+// Unwrap returns the transport that sends the requests.
+func (transport *rateLimitTransport) Unwrap() http.RoundTripper {
+	return transport.next
+}
+
+// RoundTrip waits for a token and sends the request. A 429 response throttles the bucket.
+func (transport *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	bucket := transport.getBucket()
+	if bucket != nil {
+		if err := bucket.wait(req.Context()); err != nil {
+			if req.Body != nil {
+				req.Body.Close()
+			}
+			return nil, err
+		}
+	}
+	next := transport.next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	resp, err := next.RoundTrip(req)
+	if err == nil && resp.StatusCode == http.StatusTooManyRequests && bucket != nil {
+		now := time.Now()
+		bucket.throttle(now, retryAfter(resp.Header, now))
+	}
+	return resp, err
+}
+
+// retryAfter returns the delay of the Retry-After header of a response, in seconds or as a date, or
+// DefaultThrottleBackoff if the header is missing or not valid.
+func retryAfter(header http.Header, now time.Time) time.Duration {
+	value := strings.TrimSpace(header.Get("Retry-After"))
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		if delay := at.Sub(now); delay > 0 {
+			return delay
+		}
+		return 0
+	}
+	return DefaultThrottleBackoff
+}
+
+// tokenBucket is a token bucket whose rate is lowered by 429 responses. Tokens can be reserved ahead, which makes the
+// count negative; the requests that reserved them wait for the bucket to refill.
+type tokenBucket struct {
+	mutex sync.Mutex
+
+	limit  float64
+	burst  float64
+	rate   float64
+	tokens float64
+
+	// The time up to which the tokens were refilled. It is in the future while the bucket is paused.
+	last        time.Time
+	pausedUntil time.Time
+
+	requests       int64
+	delayed        int64
+	totalDelay     time.Duration
+	throttled      int64
+	lastRetryAfter time.Duration
+}
+
+func newTokenBucket(limit float64, burst int) *tokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{
+		limit:  limit,
+		burst:  float64(burst),
+		rate:   limit,
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// advance refills the tokens and grows the rate back up to a time.
+func (bucket *tokenBucket) advance(now time.Time) {
+	elapsed := now.Sub(bucket.last).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+	bucket.tokens = math.Min(bucket.burst, bucket.tokens+elapsed*bucket.rate)
+	bucket.rate = math.Min(bucket.limit, bucket.rate+bucket.limit*elapsed/throttleRecoveryPeriod.Seconds())
+	bucket.last = now
+}
+
+// wait reserves a token and sleeps until the request can be sent. If the context is done first, the token is given
+// back and the context error is returned.
+func (bucket *tokenBucket) wait(ctx context.Context) error {
+	bucket.mutex.Lock()
+	now := time.Now()
+	bucket.advance(now)
+	bucket.tokens--
+	bucket.requests++
+	at := now
+	if bucket.tokens < 0 {
+		at = bucket.last.Add(time.Duration(-bucket.tokens / bucket.rate * float64(time.Second)))
+	}
+	if bucket.pausedUntil.After(at) {
+		at = bucket.pausedUntil
+	}
+	if at.After(now) {
+		bucket.delayed++
+	}
+	bucket.mutex.Unlock()
+
+	for {
+		delay := time.Until(at)
+		if delay <= 0 {
+			return nil
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			bucket.mutex.Lock()
+			bucket.tokens++
+			bucket.totalDelay += delay - time.Until(at)
+			bucket.mutex.Unlock()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		// A 429 response may have paused the bucket while the request waited.
+		bucket.mutex.Lock()
+		bucket.totalDelay += delay
+		if bucket.pausedUntil.After(at) {
+			at = bucket.pausedUntil
+		}
+		bucket.mutex.Unlock()
+	}
+}
+
+// throttle pauses the bucket for a delay and lowers its rate.
+func (bucket *tokenBucket) throttle(now time.Time, delay time.Duration) {
+	bucket.mutex.Lock()
+	defer bucket.mutex.Unlock()
+
+	bucket.advance(now)
+	bucket.throttled++
+	bucket.lastRetryAfter = delay
+	bucket.rate = math.Max(bucket.limit*throttleMinRateFactor, bucket.rate*throttleRateFactor)
+	bucket.tokens = math.Min(bucket.tokens, 0)
+	if until := now.Add(delay); until.After(bucket.pausedUntil) {
+		bucket.pausedUntil = until
+	}
+	if bucket.pausedUntil.After(bucket.last) {
+		bucket.last = bucket.pausedUntil
+	}
+}
+
+// stats returns the state of the bucket at a time.
+func (bucket *tokenBucket) stats(now time.Time) *ThrottleStats {
+	bucket.mutex.Lock()
+	defer bucket.mutex.Unlock()
+
+	bucket.advance(now)
+	stats := &ThrottleStats{
+		RequestsPerSecond:  bucket.limit,
+		Burst:              int(bucket.burst),
+		CurrentRate:        bucket.rate,
+		AvailableTokens:    bucket.tokens,
+		Requests:           bucket.requests,
+		DelayedRequests:    bucket.delayed,
+		TotalDelay:         bucket.totalDelay,
+		ThrottledResponses: bucket.throttled,
+		LastRetryAfter:     bucket.lastRetryAfter,
+	}
+	if bucket.pausedUntil.After(now) {
+		pausedUntil := strfmt.DateTime(bucket.pausedUntil)
+		stats.PausedUntil = &pausedUntil
+	}
+	return stats
+}
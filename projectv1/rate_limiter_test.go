@@ -0,0 +1,146 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1_test
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/project-go-sdk/projectv1"
+	"github.com/IBM/project-go-sdk/projectv1cassette"
+	"github.com/IBM/project-go-sdk/projectv1fake"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Rate limit`, func() {
+	var server *projectv1fake.Server
+	var projectService *projectv1.ProjectV1
+	var projectID string
+
+	getProject := func() error {
+		_, _, err := projectService.GetProject(projectService.NewGetProjectOptions(projectID))
+		return err
+	}
+
+	BeforeEach(func() {
+		var err error
+		server = projectv1fake.NewServer()
+		projectService, err = server.NewProjectV1()
+		Expect(err).To(BeNil())
+		project, _, err := projectService.CreateProject(projectService.NewCreateProjectOptions(
+			&projectv1.ProjectPrototypeDefinition{Name: core.StringPtr("ephemeral")}, "us-south", "Default"))
+		Expect(err).To(BeNil())
+		projectID = *project.ID
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Delays the requests beyond the burst`, func() {
+		projectService.SetRateLimit(20, 2)
+		start := time.Now()
+		for i := 0; i < 6; i++ {
+			Expect(getProject()).To(BeNil())
+		}
+		Expect(time.Since(start)).To(BeNumerically(">=", 190*time.Millisecond))
+
+		stats := projectService.GetThrottleStats()
+		Expect(stats.RequestsPerSecond).To(Equal(20.0))
+		Expect(stats.Burst).To(Equal(2))
+		Expect(stats.CurrentRate).To(Equal(20.0))
+		Expect(stats.Requests).To(Equal(int64(6)))
+		Expect(stats.DelayedRequests).To(Equal(int64(4)))
+		Expect(stats.TotalDelay).To(BeNumerically(">=", 190*time.Millisecond))
+		Expect(stats.ThrottledResponses).To(BeZero())
+		Expect(stats.PausedUntil).To(BeNil())
+	})
+	It(`Backs off after a 429 response`, func() {
+		projectService.SetRateLimit(100, 5)
+		server.InjectError(http.MethodGet, "/v1/projects/"+projectID, http.StatusTooManyRequests, "too many requests")
+
+		err := getProject()
+		Expect(err).ToNot(BeNil())
+		stats := projectService.GetThrottleStats()
+		Expect(stats.ThrottledResponses).To(Equal(int64(1)))
+		Expect(stats.LastRetryAfter).To(Equal(projectv1.DefaultThrottleBackoff))
+		Expect(stats.CurrentRate).To(BeNumerically("<", 60))
+		Expect(stats.AvailableTokens).To(BeNumerically("<=", 0))
+		Expect(time.Time(*stats.PausedUntil)).To(BeTemporally(">", time.Now()))
+
+		start := time.Now()
+		Expect(getProject()).To(BeNil())
+		Expect(time.Since(start)).To(BeNumerically(">=", 900*time.Millisecond))
+	})
+	It(`Lets the retries send the request again after a 429 response`, func() {
+		projectService.Service.EnableRetries(3, time.Millisecond)
+		projectService.SetRateLimit(100, 5)
+		server.InjectError(http.MethodGet, "/v1/projects/"+projectID, http.StatusTooManyRequests, "too many requests")
+
+		Expect(getProject()).To(BeNil())
+		stats := projectService.GetThrottleStats()
+		Expect(stats.Requests).To(Equal(int64(2)))
+		Expect(stats.ThrottledResponses).To(Equal(int64(1)))
+		Expect(stats.DelayedRequests).To(Equal(int64(1)))
+	})
+	It(`Returns an error when the context is done while the request waits`, func() {
+		projectService.SetRateLimit(1, 1)
+		Expect(getProject()).To(BeNil())
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		_, _, err := projectService.GetProjectWithContext(ctx, projectService.NewGetProjectOptions(projectID))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("context deadline exceeded"))
+		Expect(projectService.GetThrottleStats().AvailableTokens).To(BeNumerically(">", -1))
+	})
+	It(`Finds the rate limit behind the transports that are installed after it`, func() {
+		projectService.SetRateLimit(10, 1)
+		recorder, err := projectv1cassette.NewTransport(filepath.Join(GinkgoT().TempDir(), "cassette.json"), projectv1cassette.ModeRecord)
+		Expect(err).To(BeNil())
+		recorder.Install(projectService)
+
+		projectService.SetRateLimit(50, 3)
+		Expect(projectService.Service.GetHTTPClient().Transport).To(BeIdenticalTo(recorder))
+		Expect(getProject()).To(BeNil())
+		stats := projectService.GetThrottleStats()
+		Expect(stats.RequestsPerSecond).To(Equal(50.0))
+		Expect(stats.Requests).To(Equal(int64(1)))
+		Expect(recorder.Cassette().Interactions).To(HaveLen(1))
+
+		projectService.SetRateLimit(0, 0)
+		Expect(projectService.GetThrottleStats()).To(BeNil())
+		Expect(getProject()).To(BeNil())
+	})
+	It(`Replaces and removes the rate limit`, func() {
+		Expect(projectService.GetThrottleStats()).To(BeNil())
+
+		projectService.SetRateLimit(10, 0)
+		Expect(projectService.GetThrottleStats().Burst).To(Equal(1))
+		projectService.SetRateLimit(50, 3)
+		stats := projectService.GetThrottleStats()
+		Expect(stats.RequestsPerSecond).To(Equal(50.0))
+		Expect(stats.Burst).To(Equal(3))
+
+		projectService.SetRateLimit(0, 0)
+		Expect(projectService.GetThrottleStats()).To(BeNil())
+		Expect(getProject()).To(BeNil())
+	})
+})
@@ -0,0 +1,297 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// RedactedValue is the value that replaces secrets in redacted copies of models, in printed models and in logs.
+const RedactedValue = "[redacted]"
+
+// isSecureInput returns true if the schema marks an input as secure, with the password type. The schema may be nil,
+// for the inputs of a configuration whose schema is not known.
+func isSecureInput(schema *InputSchema, name string) bool {
+	if schema == nil {
+		return false
+	}
+	variable, found := schema.Variable(name)
+	return found && core.StringNilMapper(variable.Type) == StackDefinitionInputVariable_Type_Password
+}
+
+// isSecretProperty returns true if a JSON property of a definition holds a secret: the API key of the authorizations,
+// or the token of the project store. The parent is the name of the property that holds the object of the property.
+func isSecretProperty(parent string, name string) bool {
+	return parent == "authorizations" && name == "api_key" || parent == "store" && name == "token"
+}
+
+// isMaskedValue returns true if a value is one that the service masked in a response, a string of asterisks. The
+// service masks the API keys of authorizations, the tokens of project stores and the values of secure inputs.
+func isMaskedValue(value interface{}) bool {
+	masked, ok := value.(string)
+	return ok && masked != "" && strings.Trim(masked, "*") == ""
+}
+
+// RedactInputs : Return a copy of an inputs map with the values of secure inputs masked
+// Inputs are secure when the schema gives them the password type. References are not masked, because they do not hold
+// the values that they refer to.
+func (schema *InputSchema) RedactInputs(inputs map[string]interface{}) map[string]interface{} {
+	if inputs == nil {
+		return nil
+	}
+	redacted := make(map[string]interface{}, len(inputs))
+	for name, value := range inputs {
+		if isSecureInput(schema, name) && value != nil && !IsInputReference(value) {
+			value = RedactedValue
+		}
+		redacted[name] = value
+	}
+	return redacted
+}
+
+// redactModel returns a deep copy of a model, which is a pointer to a struct, with its secrets masked: the API keys of
+// authorizations, the tokens of project stores, the values of sensitive outputs and the authorization headers of
+// options. The models do not say which of their inputs are secure, so inputs are not masked.
+func redactModel(model interface{}) interface{} {
+	return redactValue(reflect.ValueOf(model), "").Interface()
+}
+
+func redactValue(value reflect.Value, name string) reflect.Value {
+	switch value.Kind() {
+	case reflect.Ptr:
+		if value.IsNil() || value.Elem().Kind() != reflect.Struct {
+			return value
+		}
+		copied := reflect.New(value.Elem().Type())
+		copied.Elem().Set(redactValue(value.Elem(), name))
+		return copied
+	case reflect.Interface:
+		if value.IsNil() {
+			return value
+		}
+		copied := reflect.New(value.Type()).Elem()
+		copied.Set(redactValue(value.Elem(), name))
+		return copied
+	case reflect.Struct:
+		copied := reflect.New(value.Type()).Elem()
+		copied.Set(value)
+		for i := 0; i < value.NumField(); i++ {
+			if copied.Field(i).CanSet() {
+				copied.Field(i).Set(redactValue(value.Field(i), value.Type().Field(i).Name))
+			}
+		}
+		maskSecrets(copied.Addr().Interface())
+		return copied
+	case reflect.Slice:
+		if value.IsNil() {
+			return value
+		}
+		if kind := value.Type().Elem().Kind(); kind != reflect.Struct && kind != reflect.Ptr && kind != reflect.Interface {
+			return value
+		}
+		copied := reflect.MakeSlice(value.Type(), value.Len(), value.Len())
+		for i := 0; i < value.Len(); i++ {
+			copied.Index(i).Set(redactValue(value.Index(i), name))
+		}
+		return copied
+	case reflect.Map:
+		if headers, ok := value.Interface().(map[string]string); ok && name == "Headers" {
+			return reflect.ValueOf(redactHeaders(headers))
+		}
+	}
+	return value
+}
+
+// maskSecrets masks the secrets of a model that holds them directly.
+func maskSecrets(model interface{}) {
+	switch m := model.(type) {
+	case *ProjectConfigAuth:
+		if core.StringNilMapper(m.ApiKey) != "" {
+			m.ApiKey = core.StringPtr(RedactedValue)
+		}
+	case *ProjectDefinitionStore:
+		if core.StringNilMapper(m.Token) != "" {
+			m.Token = core.StringPtr(RedactedValue)
+		}
+	case *OutputValue:
+		if m.Sensitive != nil && *m.Sensitive && m.Value != nil {
+			m.Value = RedactedValue
+		}
+	}
+}
+
+func redactHeaders(headers map[string]string) map[string]string {
+	if headers == nil {
+		return nil
+	}
+	redacted := make(map[string]string, len(headers))
+	for name, value := range headers {
+		lowerName := strings.ToLower(name)
+		if lowerName == "authorization" || strings.HasPrefix(lowerName, "x-auth") {
+			value = RedactedValue
+		}
+		redacted[name] = value
+	}
+	return redacted
+}
+
+// formatRedacted prints a redacted model with the verb and flags of a Format call. The model must be converted to a
+// type without a Format method, so that the printing does not recurse.
+func formatRedacted(state fmt.State, verb rune, model interface{}) {
+	directive := "%"
+	for _, flag := range "+-# 0" {
+		if state.Flag(int(flag)) {
+			directive += string(flag)
+		}
+	}
+	if width, ok := state.Width(); ok {
+		directive += strconv.Itoa(width)
+	}
+	if precision, ok := state.Precision(); ok {
+		directive += "." + strconv.Itoa(precision)
+	}
+	fmt.Fprintf(state, directive+string(verb), model)
+}
+
+// redactingLogger is a core.Logger that masks the secrets of the Projects service in the messages of another logger.
+type redactingLogger struct {
+	core.Logger
+}
+
+// The core logger is wrapped when the package is loaded, so that the requests and responses that the core logs at the
+// debug level are masked without any setup.
+func init() {
+	core.SetLogger(NewRedactingLogger(core.GetLogger()))
+}
+
+// NewRedactingLogger : Wrap a core logger to mask the secrets of the Projects service
+// The core masks the authorization headers and the JSON properties named like credentials in the requests and
+// responses that it logs at the debug level. The returned logger also masks the API keys of authorizations, the tokens
+// of project stores and the values of sensitive outputs. The package installs it around the core logger when it is
+// loaded, but a logger that is installed later with core.SetLogger is not wrapped: install it as
+// core.SetLogger(projectv1.NewRedactingLogger(logger)). A logger that is already wrapped is returned as is.
+func NewRedactingLogger(logger core.Logger) core.Logger {
+	if redacting, ok := logger.(*redactingLogger); ok {
+		return redacting
+	}
+	return &redactingLogger{Logger: logger}
+}
+
+// Log masks the secrets of a message and logs it.
+func (logger *redactingLogger) Log(level core.LogLevel, format string, inserts ...interface{}) {
+	if !logger.Logger.IsLogLevelEnabled(level) {
+		return
+	}
+	logger.Logger.Log(level, "%s", RedactLogMessage(fmt.Sprintf(format, inserts...)))
+}
+
+// Error masks the secrets of a message and logs it at the error level.
+func (logger *redactingLogger) Error(format string, inserts ...interface{}) {
+	logger.Log(core.LevelError, format, inserts...)
+}
+
+// Warn masks the secrets of a message and logs it at the warn level.
+func (logger *redactingLogger) Warn(format string, inserts ...interface{}) {
+	logger.Log(core.LevelWarn, format, inserts...)
+}
+
+// Info masks the secrets of a message and logs it at the info level.
+func (logger *redactingLogger) Info(format string, inserts ...interface{}) {
+	logger.Log(core.LevelInfo, format, inserts...)
+}
+
+// Debug masks the secrets of a message and logs it at the debug level.
+func (logger *redactingLogger) Debug(format string, inserts ...interface{}) {
+	logger.Log(core.LevelDebug, format, inserts...)
+}
+
+// RedactLogMessage : Mask the secrets of the Projects service in a log message
+// If the message is a dump of an HTTP request or response with a JSON body, the API keys of authorizations, the tokens
+// of project stores and the values of sensitive outputs of the body are masked. The secrets that core.RedactSecrets
+// masks are masked too.
+func RedactLogMessage(message string) string {
+	separator := "\r\n\r\n"
+	index := strings.Index(message, separator)
+	if index < 0 {
+		return core.RedactSecrets(message)
+	}
+	head, body := message[:index+len(separator)], message[index+len(separator):]
+	trimmed := strings.TrimRight(body, "\r\n")
+	if redacted, ok := redactJSONBody(trimmed); ok {
+		body = redacted + body[len(trimmed):]
+	}
+	return core.RedactSecrets(head + body)
+}
+
+// redactJSONBody masks the secrets of a JSON body. The second result is false if the body is not JSON or has no
+// secret, so that the bodies of other services are logged as they are.
+func redactJSONBody(body string) (string, bool) {
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil || decoder.More() {
+		return body, false
+	}
+	if !redactJSONValue(document, "") {
+		return body, false
+	}
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(document); err != nil {
+		return body, false
+	}
+	return strings.TrimRight(buffer.String(), "\n"), true
+}
+
+// redactJSONValue masks the secrets of a decoded JSON value in place, and reports whether it masked any. The parent is
+// the name of the property that holds the value.
+func redactJSONValue(value interface{}, parent string) (redacted bool) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if sensitive, _ := v["sensitive"].(bool); sensitive && v["value"] != nil {
+			v["value"] = RedactedValue
+			redacted = true
+		}
+		for name, property := range v {
+			if isSecretProperty(parent, name) {
+				if secret, ok := property.(string); ok && secret != "" {
+					v[name] = RedactedValue
+					redacted = true
+				}
+				continue
+			}
+			if redactJSONValue(property, name) {
+				redacted = true
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			if redactJSONValue(item, parent) {
+				redacted = true
+			}
+		}
+	}
+	return redacted
+}
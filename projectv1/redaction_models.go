@@ -0,0 +1,418 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1
+
+import (
+	"fmt"
+)
+
+// The plain types have the fields of the models without their methods, to print redacted copies without recursing
+// into Format.
+type (
+	plainProjectConfigAuth                                                           ProjectConfigAuth
+	plainProjectDefinitionStore                                                      ProjectDefinitionStore
+	plainOutputValue                                                                 OutputValue
+	plainProjectPrototypeDefinition                                                  ProjectPrototypeDefinition
+	plainProjectDefinition                                                           ProjectDefinition
+	plainProjectDefinitionPatch                                                      ProjectDefinitionPatch
+	plainEnvironmentDefinitionRequiredProperties                                     EnvironmentDefinitionRequiredProperties
+	plainEnvironmentDefinitionRequiredPropertiesResponse                             EnvironmentDefinitionRequiredPropertiesResponse
+	plainEnvironmentDefinitionPropertiesPatch                                        EnvironmentDefinitionPropertiesPatch
+	plainProjectConfigDefinitionPrototype                                            ProjectConfigDefinitionPrototype
+	plainProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype       ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype
+	plainProjectConfigDefinitionPrototypeResourceConfigDefinitionPropertiesPrototype ProjectConfigDefinitionPrototypeResourceConfigDefinitionPropertiesPrototype
+	plainProjectConfigDefinitionPatch                                                ProjectConfigDefinitionPatch
+	plainProjectConfigDefinitionPatchDAConfigDefinitionPropertiesPatch               ProjectConfigDefinitionPatchDAConfigDefinitionPropertiesPatch
+	plainProjectConfigDefinitionPatchResourceConfigDefinitionPropertiesPatch         ProjectConfigDefinitionPatchResourceConfigDefinitionPropertiesPatch
+	plainProjectConfigDefinitionResponse                                             ProjectConfigDefinitionResponse
+	plainProjectConfigDefinitionResponseDAConfigDefinitionPropertiesResponse         ProjectConfigDefinitionResponseDAConfigDefinitionPropertiesResponse
+	plainProjectConfigDefinitionResponseResourceConfigDefinitionPropertiesResponse   ProjectConfigDefinitionResponseResourceConfigDefinitionPropertiesResponse
+	plainProjectConfig                                                               ProjectConfig
+	plainProjectConfigVersion                                                        ProjectConfigVersion
+	plainProject                                                                     Project
+	plainEnvironment                                                                 Environment
+	plainCreateProjectOptions                                                        CreateProjectOptions
+	plainUpdateProjectOptions                                                        UpdateProjectOptions
+	plainCreateProjectEnvironmentOptions                                             CreateProjectEnvironmentOptions
+	plainUpdateProjectEnvironmentOptions                                             UpdateProjectEnvironmentOptions
+	plainCreateConfigOptions                                                         CreateConfigOptions
+	plainUpdateConfigOptions                                                         UpdateConfigOptions
+)
+
+// Redacted returns a copy of the ProjectConfigAuth with the API key masked.
+func (model *ProjectConfigAuth) Redacted() *ProjectConfigAuth {
+	if model == nil {
+		return nil
+	}
+	return redactModel(model).(*ProjectConfigAuth)
+}
+
+// Format implements fmt.Formatter to print the ProjectConfigAuth with the API key masked.
+func (model ProjectConfigAuth) Format(state fmt.State, verb rune) {
+	formatRedacted(state, verb, plainProjectConfigAuth(*model.Redacted()))
+}
+
+// Redacted returns a copy of the ProjectDefinitionStore with the token masked.
+func (model *ProjectDefinitionStore) Redacted() *ProjectDefinitionStore {
+	if model == nil {
+		return nil
+	}
+	return redactModel(model).(*ProjectDefinitionStore)
+}
+
+// Format implements fmt.Formatter to print the ProjectDefinitionStore with the token masked.
+func (model ProjectDefinitionStore) Format(state fmt.State, verb rune) {
+	formatRedacted(state, verb, plainProjectDefinitionStore(*model.Redacted()))
+}
+
+// Redacted returns a copy of the OutputValue with the value masked if the output is sensitive.
+func (model *OutputValue) Redacted() *OutputValue {
+	if model == nil {
+		return nil
+	}
+	return redactModel(model).(*OutputValue)
+}
+
+// Format implements fmt.Formatter to print the OutputValue with the value masked if the output is sensitive.
+func (model OutputValue) Format(state fmt.State, verb rune) {
+	formatRedacted(state, verb, plainOutputValue(*model.Redacted()))
+}
+
+// Redacted returns a copy of the ProjectPrototypeDefinition with the token of the store masked.
+func (model *ProjectPrototypeDefinition) Redacted() *ProjectPrototypeDefinition {
+	if model == nil {
+		return nil
+	}
+	return redactModel(model).(*ProjectPrototypeDefinition)
+}
+
+// Format implements fmt.Formatter to print the ProjectPrototypeDefinition with the token of the store masked.
+func (model ProjectPrototypeDefinition) Format(state fmt.State, verb rune) {
+	formatRedacted(state, verb, plainProjectPrototypeDefinition(*model.Redacted()))
+}
+
+// Redacted returns a copy of the ProjectDefinition with its secrets masked.
+func (model *ProjectDefinition) Redacted() *ProjectDefinition {
+	if model == nil {
+		return nil
+	}
+	return redactModel(model).(*ProjectDefinition)
+}
+
+// Format implements fmt.Formatter to print the ProjectDefinition with its secrets masked.
+func (model ProjectDefinition) Format(state fmt.State, verb rune) {
+	formatRedacted(state, verb, plainProjectDefinition(*model.Redacted()))
+}
+
+// Redacted returns a copy of the ProjectDefinitionPatch with its secrets masked.
+func (model *ProjectDefinitionPatch) Redacted() *ProjectDefinitionPatch {
+	if model == nil {
+		return nil
+	}
+	return redactModel(model).(*ProjectDefinitionPatch)
+}
+
+// Format implements fmt.Formatter to print the ProjectDefinitionPatch with its secrets masked.
+func (model ProjectDefinitionPatch) Format(state fmt.State, verb rune) {
+	formatRedacted(state, verb, plainProjectDefinitionPatch(*model.Redacted()))
+}
+
+// Redacted returns a copy of the EnvironmentDefinitionRequiredProperties with the API key masked.
+func (model *EnvironmentDefinitionRequiredProperties) Redacted() *EnvironmentDefinitionRequiredProperties {
+	if model == nil {
+		return nil
+	}
+	return redactModel(model).(*EnvironmentDefinitionRequiredProperties)
+}
+
+// Format implements fmt.Formatter to print the EnvironmentDefinitionRequiredProperties with the API key masked.
+func (model EnvironmentDefinitionRequiredProperties) Format(state fmt.State, verb rune) {
+	formatRedacted(state, verb, plainEnvironmentDefinitionRequiredProperties(*model.Redacted()))
+}
+
+// Redacted returns a copy of the EnvironmentDefinitionRequiredPropertiesResponse with its secrets masked.
+func (model *EnvironmentDefinitionRequiredPropertiesResponse) Redacted() *EnvironmentDefinitionRequiredPropertiesResponse {
+	if model == nil {
+		return nil
+	}
+	return redactModel(model).(*EnvironmentDefinitionRequiredPropertiesResponse)
+}
+
+// Format implements fmt.Formatter to print the EnvironmentDefinitionRequiredPropertiesResponse with its secrets masked.
+func (model EnvironmentDefinitionRequiredPropertiesResponse) Format(state fmt.State, verb rune) {
+	formatRedacted(state, verb, plainEnvironmentDefinitionRequiredPropertiesResponse(*model.Redacted()))
+}
+
+// Redacted returns a copy of the EnvironmentDefinitionPropertiesPatch with its secrets masked.
+func (model *EnvironmentDefinitionPropertiesPatch) Redacted() *EnvironmentDefinitionPropertiesPatch {
+	if model == nil {
+		return nil
+	}
+	return redactModel(model).(*EnvironmentDefinitionPropertiesPatch)
+}
+
+// Format implements fmt.Formatter to print the EnvironmentDefinitionPropertiesPatch with its secrets masked.
+func (model EnvironmentDefinitionPropertiesPatch) Format(state fmt.State, verb rune) {
+	formatRedacted(state, verb, plainEnvironmentDefinitionPropertiesPatch(*model.Redacted()))
+}
+
+// Redacted returns a copy of the ProjectConfigDefinitionPrototype with its secrets masked.
+func (model *ProjectConfigDefinitionPrototype) Redacted() *ProjectConfigDefinitionPrototype {
+	if model == nil {
+		return nil
+	}
+	return redactModel(model).(*ProjectConfigDefinitionPrototype)
+}
+
+// Format implements fmt.Formatter to print the ProjectConfigDefinitionPrototype with its secrets masked.
+func (model ProjectConfigDefinitionPrototype) Format(state fmt.State, verb rune) {
+	formatRedacted(state, verb, plainProjectConfigDefinitionPrototype(*model.Redacted()))
+}
+
+// Redacted returns a copy of the ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype with its secrets masked.
+func (model *ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype) Redacted() *ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype {
+	if model == nil {
+		return nil
+	}
+	return redactModel(model).(*ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype)
+}
+
+// Format implements fmt.Formatter to print the ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype with its secrets masked.
+func (model ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype) Format(state fmt.State, verb rune) {
+	formatRedacted(state, verb, plainProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype(*model.Redacted()))
+}
+
+// Redacted returns a copy of the ProjectConfigDefinitionPrototypeResourceConfigDefinitionPropertiesPrototype with its secrets masked.
+func (model *ProjectConfigDefinitionPrototypeResourceConfigDefinitionPropertiesPrototype) Redacted() *ProjectConfigDefinitionPrototypeResourceConfigDefinitionPropertiesPrototype {
+	if model == nil {
+		return nil
+	}
+	return redactModel(model).(*ProjectConfigDefinitionPrototypeResourceConfigDefinitionPropertiesPrototype)
+}
+
+// Format implements fmt.Formatter to print the ProjectConfigDefinitionPrototypeResourceConfigDefinitionPropertiesPrototype with its secrets masked.
+func (model ProjectConfigDefinitionPrototypeResourceConfigDefinitionPropertiesPrototype) Format(state fmt.State, verb rune) {
+	formatRedacted(state, verb, plainProjectConfigDefinitionPrototypeResourceConfigDefinitionPropertiesPrototype(*model.Redacted()))
+}
+
+// Redacted returns a copy of the ProjectConfigDefinitionPatch with its secrets masked.
+func (model *ProjectConfigDefinitionPatch) Redacted() *ProjectConfigDefinitionPatch {
+	if model == nil {
+		return nil
+	}
+	return redactModel(model).(*ProjectConfigDefinitionPatch)
+}
+
+// Format implements fmt.Formatter to print the ProjectConfigDefinitionPatch with its secrets masked.
+func (model ProjectConfigDefinitionPatch) Format(state fmt.State, verb rune) {
+	formatRedacted(state, verb, plainProjectConfigDefinitionPatch(*model.Redacted()))
+}
+
+// Redacted returns a copy of the ProjectConfigDefinitionPatchDAConfigDefinitionPropertiesPatch with its secrets masked.
+func (model *ProjectConfigDefinitionPatchDAConfigDefinitionPropertiesPatch) Redacted() *ProjectConfigDefinitionPatchDAConfigDefinitionPropertiesPatch {
+	if model == nil {
+		return nil
+	}
+	return redactModel(model).(*ProjectConfigDefinitionPatchDAConfigDefinitionPropertiesPatch)
+}
+
+// Format implements fmt.Formatter to print the ProjectConfigDefinitionPatchDAConfigDefinitionPropertiesPatch with its secrets masked.
+func (model ProjectConfigDefinitionPatchDAConfigDefinitionPropertiesPatch) Format(state fmt.State, verb rune) {
+	formatRedacted(state, verb, plainProjectConfigDefinitionPatchDAConfigDefinitionPropertiesPatch(*model.Redacted()))
+}
+
+// Redacted returns a copy of the ProjectConfigDefinitionPatchResourceConfigDefinitionPropertiesPatch with its secrets masked.
+func (model *ProjectConfigDefinitionPatchResourceConfigDefinitionPropertiesPatch) Redacted() *ProjectConfigDefinitionPatchResourceConfigDefinitionPropertiesPatch {
+	if model == nil {
+		return nil
+	}
+	return redactModel(model).(*ProjectConfigDefinitionPatchResourceConfigDefinitionPropertiesPatch)
+}
+
+// Format implements fmt.Formatter to print the ProjectConfigDefinitionPatchResourceConfigDefinitionPropertiesPatch with its secrets masked.
+func (model ProjectConfigDefinitionPatchResourceConfigDefinitionPropertiesPatch) Format(state fmt.State, verb rune) {
+	formatRedacted(state, verb, plainProjectConfigDefinitionPatchResourceConfigDefinitionPropertiesPatch(*model.Redacted()))
+}
+
+// Redacted returns a copy of the ProjectConfigDefinitionResponse with its secrets masked.
+func (model *ProjectConfigDefinitionResponse) Redacted() *ProjectConfigDefinitionResponse {
+	if model == nil {
+		return nil
+	}
+	return redactModel(model).(*ProjectConfigDefinitionResponse)
+}
+
+// Format implements fmt.Formatter to print the ProjectConfigDefinitionResponse with its secrets masked.
+func (model ProjectConfigDefinitionResponse) Format(state fmt.State, verb rune) {
+	formatRedacted(state, verb, plainProjectConfigDefinitionResponse(*model.Redacted()))
+}
+
+// Redacted returns a copy of the ProjectConfigDefinitionResponseDAConfigDefinitionPropertiesResponse with its secrets masked.
+func (model *ProjectConfigDefinitionResponseDAConfigDefinitionPropertiesResponse) Redacted() *ProjectConfigDefinitionResponseDAConfigDefinitionPropertiesResponse {
+	if model == nil {
+		return nil
+	}
+	return redactModel(model).(*ProjectConfigDefinitionResponseDAConfigDefinitionPropertiesResponse)
+}
+
+// Format implements fmt.Formatter to print the ProjectConfigDefinitionResponseDAConfigDefinitionPropertiesResponse with its secrets masked.
+func (model ProjectConfigDefinitionResponseDAConfigDefinitionPropertiesResponse) Format(state fmt.State, verb rune) {
+	formatRedacted(state, verb, plainProjectConfigDefinitionResponseDAConfigDefinitionPropertiesResponse(*model.Redacted()))
+}
+
+// Redacted returns a copy of the ProjectConfigDefinitionResponseResourceConfigDefinitionPropertiesResponse with its secrets masked.
+func (model *ProjectConfigDefinitionResponseResourceConfigDefinitionPropertiesResponse) Redacted() *ProjectConfigDefinitionResponseResourceConfigDefinitionPropertiesResponse {
+	if model == nil {
+		return nil
+	}
+	return redactModel(model).(*ProjectConfigDefinitionResponseResourceConfigDefinitionPropertiesResponse)
+}
+
+// Format implements fmt.Formatter to print the ProjectConfigDefinitionResponseResourceConfigDefinitionPropertiesResponse with its secrets masked.
+func (model ProjectConfigDefinitionResponseResourceConfigDefinitionPropertiesResponse) Format(state fmt.State, verb rune) {
+	formatRedacted(state, verb, plainProjectConfigDefinitionResponseResourceConfigDefinitionPropertiesResponse(*model.Redacted()))
+}
+
+// Redacted returns a copy of the ProjectConfig with the secrets of the definition and the sensitive outputs masked.
+func (model *ProjectConfig) Redacted() *ProjectConfig {
+	if model == nil {
+		return nil
+	}
+	return redactModel(model).(*ProjectConfig)
+}
+
+// Format implements fmt.Formatter to print the ProjectConfig with the secrets of the definition and the sensitive outputs masked.
+func (model ProjectConfig) Format(state fmt.State, verb rune) {
+	formatRedacted(state, verb, plainProjectConfig(*model.Redacted()))
+}
+
+// Redacted returns a copy of the ProjectConfigVersion with its secrets masked.
+func (model *ProjectConfigVersion) Redacted() *ProjectConfigVersion {
+	if model == nil {
+		return nil
+	}
+	return redactModel(model).(*ProjectConfigVersion)
+}
+
+// Format implements fmt.Formatter to print the ProjectConfigVersion with its secrets masked.
+func (model ProjectConfigVersion) Format(state fmt.State, verb rune) {
+	formatRedacted(state, verb, plainProjectConfigVersion(*model.Redacted()))
+}
+
+// Redacted returns a copy of the Project with the token of the store masked.
+func (model *Project) Redacted() *Project {
+	if model == nil {
+		return nil
+	}
+	return redactModel(model).(*Project)
+}
+
+// Format implements fmt.Formatter to print the Project with the token of the store masked.
+func (model Project) Format(state fmt.State, verb rune) {
+	formatRedacted(state, verb, plainProject(*model.Redacted()))
+}
+
+// Redacted returns a copy of the Environment with the API key masked.
+func (model *Environment) Redacted() *Environment {
+	if model == nil {
+		return nil
+	}
+	return redactModel(model).(*Environment)
+}
+
+// Format implements fmt.Formatter to print the Environment with the API key masked.
+func (model Environment) Format(state fmt.State, verb rune) {
+	formatRedacted(state, verb, plainEnvironment(*model.Redacted()))
+}
+
+// Redacted returns a copy of the CreateProjectOptions with the secrets of the definitions and the authorization headers masked.
+func (options *CreateProjectOptions) Redacted() *CreateProjectOptions {
+	if options == nil {
+		return nil
+	}
+	return redactModel(options).(*CreateProjectOptions)
+}
+
+// Format implements fmt.Formatter to print the CreateProjectOptions with the secrets of the definitions and the authorization headers masked.
+func (options CreateProjectOptions) Format(state fmt.State, verb rune) {
+	formatRedacted(state, verb, plainCreateProjectOptions(*options.Redacted()))
+}
+
+// Redacted returns a copy of the UpdateProjectOptions with its secrets masked.
+func (options *UpdateProjectOptions) Redacted() *UpdateProjectOptions {
+	if options == nil {
+		return nil
+	}
+	return redactModel(options).(*UpdateProjectOptions)
+}
+
+// Format implements fmt.Formatter to print the UpdateProjectOptions with its secrets masked.
+func (options UpdateProjectOptions) Format(state fmt.State, verb rune) {
+	formatRedacted(state, verb, plainUpdateProjectOptions(*options.Redacted()))
+}
+
+// Redacted returns a copy of the CreateProjectEnvironmentOptions with its secrets masked.
+func (options *CreateProjectEnvironmentOptions) Redacted() *CreateProjectEnvironmentOptions {
+	if options == nil {
+		return nil
+	}
+	return redactModel(options).(*CreateProjectEnvironmentOptions)
+}
+
+// Format implements fmt.Formatter to print the CreateProjectEnvironmentOptions with its secrets masked.
+func (options CreateProjectEnvironmentOptions) Format(state fmt.State, verb rune) {
+	formatRedacted(state, verb, plainCreateProjectEnvironmentOptions(*options.Redacted()))
+}
+
+// Redacted returns a copy of the UpdateProjectEnvironmentOptions with its secrets masked.
+func (options *UpdateProjectEnvironmentOptions) Redacted() *UpdateProjectEnvironmentOptions {
+	if options == nil {
+		return nil
+	}
+	return redactModel(options).(*UpdateProjectEnvironmentOptions)
+}
+
+// Format implements fmt.Formatter to print the UpdateProjectEnvironmentOptions with its secrets masked.
+func (options UpdateProjectEnvironmentOptions) Format(state fmt.State, verb rune) {
+	formatRedacted(state, verb, plainUpdateProjectEnvironmentOptions(*options.Redacted()))
+}
+
+// Redacted returns a copy of the CreateConfigOptions with its secrets masked.
+func (options *CreateConfigOptions) Redacted() *CreateConfigOptions {
+	if options == nil {
+		return nil
+	}
+	return redactModel(options).(*CreateConfigOptions)
+}
+
+// Format implements fmt.Formatter to print the CreateConfigOptions with its secrets masked.
+func (options CreateConfigOptions) Format(state fmt.State, verb rune) {
+	formatRedacted(state, verb, plainCreateConfigOptions(*options.Redacted()))
+}
+
+// Redacted returns a copy of the UpdateConfigOptions with its secrets masked.
+func (options *UpdateConfigOptions) Redacted() *UpdateConfigOptions {
+	if options == nil {
+		return nil
+	}
+	return redactModel(options).(*UpdateConfigOptions)
+}
+
+// Format implements fmt.Formatter to print the UpdateConfigOptions with its secrets masked.
+func (options UpdateConfigOptions) Format(state fmt.State, verb rune) {
+	formatRedacted(state, verb, plainUpdateConfigOptions(*options.Redacted()))
+}
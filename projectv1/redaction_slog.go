@@ -0,0 +1,163 @@
+//go:build go1.21
+
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1
+
+import (
+	"log/slog"
+)
+
+// LogValue implements slog.LogValuer to log the ProjectConfigAuth with the API key masked.
+func (model ProjectConfigAuth) LogValue() slog.Value {
+	return slog.AnyValue(plainProjectConfigAuth(*model.Redacted()))
+}
+
+// LogValue implements slog.LogValuer to log the ProjectDefinitionStore with the token masked.
+func (model ProjectDefinitionStore) LogValue() slog.Value {
+	return slog.AnyValue(plainProjectDefinitionStore(*model.Redacted()))
+}
+
+// LogValue implements slog.LogValuer to log the OutputValue with the value masked if the output is sensitive.
+func (model OutputValue) LogValue() slog.Value {
+	return slog.AnyValue(plainOutputValue(*model.Redacted()))
+}
+
+// LogValue implements slog.LogValuer to log the ProjectPrototypeDefinition with the token of the store masked.
+func (model ProjectPrototypeDefinition) LogValue() slog.Value {
+	return slog.AnyValue(plainProjectPrototypeDefinition(*model.Redacted()))
+}
+
+// LogValue implements slog.LogValuer to log the ProjectDefinition with its secrets masked.
+func (model ProjectDefinition) LogValue() slog.Value {
+	return slog.AnyValue(plainProjectDefinition(*model.Redacted()))
+}
+
+// LogValue implements slog.LogValuer to log the ProjectDefinitionPatch with its secrets masked.
+func (model ProjectDefinitionPatch) LogValue() slog.Value {
+	return slog.AnyValue(plainProjectDefinitionPatch(*model.Redacted()))
+}
+
+// LogValue implements slog.LogValuer to log the EnvironmentDefinitionRequiredProperties with the API key masked.
+func (model EnvironmentDefinitionRequiredProperties) LogValue() slog.Value {
+	return slog.AnyValue(plainEnvironmentDefinitionRequiredProperties(*model.Redacted()))
+}
+
+// LogValue implements slog.LogValuer to log the EnvironmentDefinitionRequiredPropertiesResponse with its secrets masked.
+func (model EnvironmentDefinitionRequiredPropertiesResponse) LogValue() slog.Value {
+	return slog.AnyValue(plainEnvironmentDefinitionRequiredPropertiesResponse(*model.Redacted()))
+}
+
+// LogValue implements slog.LogValuer to log the EnvironmentDefinitionPropertiesPatch with its secrets masked.
+func (model EnvironmentDefinitionPropertiesPatch) LogValue() slog.Value {
+	return slog.AnyValue(plainEnvironmentDefinitionPropertiesPatch(*model.Redacted()))
+}
+
+// LogValue implements slog.LogValuer to log the ProjectConfigDefinitionPrototype with its secrets masked.
+func (model ProjectConfigDefinitionPrototype) LogValue() slog.Value {
+	return slog.AnyValue(plainProjectConfigDefinitionPrototype(*model.Redacted()))
+}
+
+// LogValue implements slog.LogValuer to log the ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype with its secrets masked.
+func (model ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype) LogValue() slog.Value {
+	return slog.AnyValue(plainProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype(*model.Redacted()))
+}
+
+// LogValue implements slog.LogValuer to log the ProjectConfigDefinitionPrototypeResourceConfigDefinitionPropertiesPrototype with its secrets masked.
+func (model ProjectConfigDefinitionPrototypeResourceConfigDefinitionPropertiesPrototype) LogValue() slog.Value {
+	return slog.AnyValue(plainProjectConfigDefinitionPrototypeResourceConfigDefinitionPropertiesPrototype(*model.Redacted()))
+}
+
+// LogValue implements slog.LogValuer to log the ProjectConfigDefinitionPatch with its secrets masked.
+func (model ProjectConfigDefinitionPatch) LogValue() slog.Value {
+	return slog.AnyValue(plainProjectConfigDefinitionPatch(*model.Redacted()))
+}
+
+// LogValue implements slog.LogValuer to log the ProjectConfigDefinitionPatchDAConfigDefinitionPropertiesPatch with its secrets masked.
+func (model ProjectConfigDefinitionPatchDAConfigDefinitionPropertiesPatch) LogValue() slog.Value {
+	return slog.AnyValue(plainProjectConfigDefinitionPatchDAConfigDefinitionPropertiesPatch(*model.Redacted()))
+}
+
+// LogValue implements slog.LogValuer to log the ProjectConfigDefinitionPatchResourceConfigDefinitionPropertiesPatch with its secrets masked.
+func (model ProjectConfigDefinitionPatchResourceConfigDefinitionPropertiesPatch) LogValue() slog.Value {
+	return slog.AnyValue(plainProjectConfigDefinitionPatchResourceConfigDefinitionPropertiesPatch(*model.Redacted()))
+}
+
+// LogValue implements slog.LogValuer to log the ProjectConfigDefinitionResponse with its secrets masked.
+func (model ProjectConfigDefinitionResponse) LogValue() slog.Value {
+	return slog.AnyValue(plainProjectConfigDefinitionResponse(*model.Redacted()))
+}
+
+// LogValue implements slog.LogValuer to log the ProjectConfigDefinitionResponseDAConfigDefinitionPropertiesResponse with its secrets masked.
+func (model ProjectConfigDefinitionResponseDAConfigDefinitionPropertiesResponse) LogValue() slog.Value {
+	return slog.AnyValue(plainProjectConfigDefinitionResponseDAConfigDefinitionPropertiesResponse(*model.Redacted()))
+}
+
+// LogValue implements slog.LogValuer to log the ProjectConfigDefinitionResponseResourceConfigDefinitionPropertiesResponse with its secrets masked.
+func (model ProjectConfigDefinitionResponseResourceConfigDefinitionPropertiesResponse) LogValue() slog.Value {
+	return slog.AnyValue(plainProjectConfigDefinitionResponseResourceConfigDefinitionPropertiesResponse(*model.Redacted()))
+}
+
+// LogValue implements slog.LogValuer to log the ProjectConfig with the secrets of the definition and the sensitive outputs masked.
+func (model ProjectConfig) LogValue() slog.Value {
+	return slog.AnyValue(plainProjectConfig(*model.Redacted()))
+}
+
+// LogValue implements slog.LogValuer to log the ProjectConfigVersion with its secrets masked.
+func (model ProjectConfigVersion) LogValue() slog.Value {
+	return slog.AnyValue(plainProjectConfigVersion(*model.Redacted()))
+}
+
+// LogValue implements slog.LogValuer to log the Project with the token of the store masked.
+func (model Project) LogValue() slog.Value {
+	return slog.AnyValue(plainProject(*model.Redacted()))
+}
+
+// LogValue implements slog.LogValuer to log the Environment with the API key masked.
+func (model Environment) LogValue() slog.Value {
+	return slog.AnyValue(plainEnvironment(*model.Redacted()))
+}
+
+// LogValue implements slog.LogValuer to log the CreateProjectOptions with the secrets of the definitions and the authorization headers masked.
+func (options CreateProjectOptions) LogValue() slog.Value {
+	return slog.AnyValue(plainCreateProjectOptions(*options.Redacted()))
+}
+
+// LogValue implements slog.LogValuer to log the UpdateProjectOptions with its secrets masked.
+func (options UpdateProjectOptions) LogValue() slog.Value {
+	return slog.AnyValue(plainUpdateProjectOptions(*options.Redacted()))
+}
+
+// LogValue implements slog.LogValuer to log the CreateProjectEnvironmentOptions with its secrets masked.
+func (options CreateProjectEnvironmentOptions) LogValue() slog.Value {
+	return slog.AnyValue(plainCreateProjectEnvironmentOptions(*options.Redacted()))
+}
+
+// LogValue implements slog.LogValuer to log the UpdateProjectEnvironmentOptions with its secrets masked.
+func (options UpdateProjectEnvironmentOptions) LogValue() slog.Value {
+	return slog.AnyValue(plainUpdateProjectEnvironmentOptions(*options.Redacted()))
+}
+
+// LogValue implements slog.LogValuer to log the CreateConfigOptions with its secrets masked.
+func (options CreateConfigOptions) LogValue() slog.Value {
+	return slog.AnyValue(plainCreateConfigOptions(*options.Redacted()))
+}
+
+// LogValue implements slog.LogValuer to log the UpdateConfigOptions with its secrets masked.
+func (options UpdateConfigOptions) LogValue() slog.Value {
+	return slog.AnyValue(plainUpdateConfigOptions(*options.Redacted()))
+}
@@ -0,0 +1,65 @@
+//go:build go1.21
+
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1_test
+
+import (
+	"bytes"
+	"log/slog"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/project-go-sdk/projectv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Redaction with slog`, func() {
+	It(`Masks secrets when models are logged`, func() {
+		project := &projectv1.Project{
+			ID: core.StringPtr("project-id"),
+			Definition: &projectv1.ProjectDefinition{
+				Name:  core.StringPtr("my-project"),
+				Store: &projectv1.ProjectDefinitionStore{Type: core.StringPtr("gh"), Token: core.StringPtr("my-store-token")},
+			},
+		}
+		store := &projectv1.ProjectDefinitionStore{Type: core.StringPtr("gh"), Token: core.StringPtr("my-token")}
+
+		var buffer bytes.Buffer
+		slog.New(slog.NewJSONHandler(&buffer, nil)).Info("loaded", "project", project, "store", store)
+		Expect(buffer.String()).To(ContainSubstring(`"name":"my-project"`))
+		Expect(buffer.String()).To(ContainSubstring(`"token":"[redacted]"`))
+		Expect(buffer.String()).ToNot(ContainSubstring("my-token"))
+		Expect(buffer.String()).ToNot(ContainSubstring("my-store-token"))
+
+		buffer.Reset()
+		slog.New(slog.NewTextHandler(&buffer, nil)).Info("loaded", "project", project, "store", store)
+		Expect(buffer.String()).ToNot(ContainSubstring("my-token"))
+		Expect(buffer.String()).ToNot(ContainSubstring("my-store-token"))
+	})
+	It(`Masks secrets when models are logged as values`, func() {
+		output := projectv1.OutputValue{Name: core.StringPtr("admin"), Value: "root:hunter2", Sensitive: core.BoolPtr(true)}
+		store := projectv1.ProjectDefinitionStore{Type: core.StringPtr("gh"), Token: core.StringPtr("my-token")}
+
+		var buffer bytes.Buffer
+		slog.New(slog.NewJSONHandler(&buffer, nil)).Info("loaded", "output", output, "store", store)
+		Expect(buffer.String()).To(ContainSubstring(`"value":"[redacted]"`))
+		Expect(buffer.String()).To(ContainSubstring(`"token":"[redacted]"`))
+		Expect(buffer.String()).ToNot(ContainSubstring("hunter2"))
+		Expect(buffer.String()).ToNot(ContainSubstring("my-token"))
+	})
+})
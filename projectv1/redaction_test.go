@@ -0,0 +1,175 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1_test
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/project-go-sdk/projectv1"
+	"github.com/IBM/project-go-sdk/projectv1fake"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Redaction`, func() {
+	var createConfigOptions *projectv1.CreateConfigOptions
+
+	BeforeEach(func() {
+		createConfigOptions = (&projectv1.ProjectV1{}).NewCreateConfigOptions("project-id",
+			&projectv1.ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype{
+				Name: core.StringPtr("my-config"),
+				Authorizations: &projectv1.ProjectConfigAuth{
+					Method: core.StringPtr("api_key"),
+					ApiKey: core.StringPtr("my-api-key"),
+				},
+				Inputs: map[string]interface{}{
+					"region":    "us-south",
+					"token_ttl": 3600,
+				},
+			}).SetHeaders(map[string]string{"Authorization": "Bearer my-token", "X-Request-Id": "1"})
+	})
+
+	It(`Returns redacted copies without changing the originals`, func() {
+		redacted := createConfigOptions.Redacted()
+		definition := redacted.Definition.(*projectv1.ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype)
+		Expect(*definition.Authorizations.ApiKey).To(Equal(projectv1.RedactedValue))
+		Expect(*definition.Authorizations.Method).To(Equal("api_key"))
+		Expect(definition.Inputs).To(Equal(map[string]interface{}{"region": "us-south", "token_ttl": 3600}))
+		Expect(redacted.Headers).To(Equal(map[string]string{"Authorization": projectv1.RedactedValue, "X-Request-Id": "1"}))
+
+		original := createConfigOptions.Definition.(*projectv1.ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype)
+		Expect(*original.Authorizations.ApiKey).To(Equal("my-api-key"))
+		Expect(createConfigOptions.Headers["Authorization"]).To(Equal("Bearer my-token"))
+
+		store := &projectv1.ProjectDefinitionStore{Type: core.StringPtr("gh"), Token: core.StringPtr("my-token")}
+		Expect(*store.Redacted().Token).To(Equal(projectv1.RedactedValue))
+		Expect(*store.Token).To(Equal("my-token"))
+
+		output := &projectv1.OutputValue{Name: core.StringPtr("key"), Value: "my-key", Sensitive: core.BoolPtr(true)}
+		Expect(output.Redacted().Value).To(Equal(projectv1.RedactedValue))
+		Expect(output.Value).To(Equal("my-key"))
+		output.Sensitive = core.BoolPtr(false)
+		Expect(output.Redacted().Value).To(Equal("my-key"))
+
+		var nilOptions *projectv1.CreateConfigOptions
+		Expect(nilOptions.Redacted()).To(BeNil())
+	})
+	It(`Masks secrets when models are printed`, func() {
+		for _, format := range []string{"%v", "%+v", "%#v", "%s"} {
+			printed := fmt.Sprintf(format, createConfigOptions)
+			Expect(printed).ToNot(ContainSubstring("my-api-key"), format)
+			Expect(printed).ToNot(ContainSubstring("my-token"), format)
+		}
+		printed := fmt.Sprintf("%+v", createConfigOptions.Definition)
+		Expect(printed).To(ContainSubstring("ApiKey:"))
+		Expect(printed).To(ContainSubstring("region:us-south"))
+		Expect(printed).ToNot(ContainSubstring("my-api-key"))
+
+		var nilAuth *projectv1.ProjectConfigAuth
+		Expect(fmt.Sprintf("%v", nilAuth)).To(Equal("<nil>"))
+	})
+	It(`Masks sensitive outputs when outputs are printed as values`, func() {
+		outputs := []projectv1.OutputValue{
+			{Name: core.StringPtr("vpc_id"), Value: "r006-1234"},
+			{Name: core.StringPtr("admin"), Value: "root:hunter2", Sensitive: core.BoolPtr(true)},
+		}
+		for _, format := range []string{"%v", "%+v", "%#v", "%s"} {
+			for _, printed := range []string{fmt.Sprintf(format, outputs), fmt.Sprintf(format, outputs[1]), fmt.Sprintf(format, &outputs[1])} {
+				Expect(printed).ToNot(ContainSubstring("hunter2"), format)
+				Expect(printed).To(ContainSubstring(projectv1.RedactedValue), format)
+			}
+		}
+		Expect(fmt.Sprintf("%+v", outputs)).To(ContainSubstring("r006-1234"))
+		Expect(outputs[1].Value).To(Equal("root:hunter2"))
+
+		config := projectv1.ProjectConfig{Outputs: outputs}
+		Expect(fmt.Sprintf("%+v", config.Outputs)).ToNot(ContainSubstring("hunter2"))
+		Expect(fmt.Sprintf("%+v", config)).ToNot(ContainSubstring("hunter2"))
+	})
+	It(`Redacts the secure inputs of an input schema`, func() {
+		schema := projectv1.NewInputSchema([]projectv1.StackDefinitionInputVariable{
+			{Name: core.StringPtr("db_credentials"), Type: core.StringPtr(projectv1.StackDefinitionInputVariable_Type_Password)},
+			{Name: core.StringPtr("region"), Type: core.StringPtr(projectv1.StackDefinitionInputVariable_Type_String)},
+		})
+		Expect(schema.RedactInputs(map[string]interface{}{"db_credentials": "user:pass", "region": "us-south", "token_ttl": 3600})).To(Equal(
+			map[string]interface{}{"db_credentials": projectv1.RedactedValue, "region": "us-south", "token_ttl": 3600}))
+		Expect(schema.RedactInputs(map[string]interface{}{"db_credentials": "ref:../environments/dev/inputs/db_credentials"})).To(Equal(
+			map[string]interface{}{"db_credentials": "ref:../environments/dev/inputs/db_credentials"}))
+	})
+	It(`Masks secrets in HTTP message logs`, func() {
+		message := "HTTP/1.1 200 OK\r\nContent-Type: application/json\r\n\r\n" +
+			`{"outputs":[{"name":"vpc_id","value":"r006-1234"},{"name":"admin","value":{"user":"root"},"sensitive":true}],` +
+			`"definition":{"authorizations":{"method":"api_key","api_key":"my-api-key"},"inputs":{"token_ttl":3600,"count":3}}}`
+		redacted := projectv1.RedactLogMessage(message)
+		Expect(redacted).To(HavePrefix("HTTP/1.1 200 OK\r\nContent-Type: application/json\r\n\r\n"))
+		Expect(redacted).To(ContainSubstring(`{"name":"vpc_id","value":"r006-1234"}`))
+		Expect(redacted).To(ContainSubstring(`"value":"[redacted]"`))
+		Expect(redacted).To(ContainSubstring(`"token_ttl":3600`))
+		Expect(redacted).To(ContainSubstring(`"count":3`))
+		Expect(redacted).ToNot(ContainSubstring("my-api-key"))
+		Expect(redacted).ToNot(ContainSubstring("root"))
+
+		redacted = projectv1.RedactLogMessage("GET /v1/projects HTTP/1.1\r\nAuthorization: Bearer my-token\r\n\r\n")
+		Expect(redacted).To(ContainSubstring("Authorization: [redacted]"))
+		Expect(redacted).ToNot(ContainSubstring("my-token"))
+	})
+	It(`Is installed around the core logger when the package is loaded`, func() {
+		logger := core.GetLogger()
+		Expect(projectv1.NewRedactingLogger(logger)).To(BeIdenticalTo(logger))
+
+		message := "HTTP/1.1 200 OK\r\n\r\n{ \"name\": \"my-project\" }"
+		Expect(projectv1.RedactLogMessage(message)).To(Equal(message))
+	})
+	It(`Masks sensitive outputs in the debug logs of the core`, func() {
+		server := projectv1fake.NewServer()
+		defer server.Close()
+		projectService, err := server.NewProjectV1()
+		Expect(err).To(BeNil())
+
+		project, _, err := projectService.CreateProject(projectService.NewCreateProjectOptions(
+			&projectv1.ProjectPrototypeDefinition{Name: core.StringPtr("my-project")}, "us-south", "Default"))
+		Expect(err).To(BeNil())
+		config, _, err := projectService.CreateConfig(projectService.NewCreateConfigOptions(*project.ID,
+			&projectv1.ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype{Name: core.StringPtr("my-config")}))
+		Expect(err).To(BeNil())
+		server.SetDeployOutputs(*config.ID, []projectv1.OutputValue{
+			{Name: core.StringPtr("admin_credentials"), Value: "root:hunter2", Sensitive: core.BoolPtr(true)},
+		})
+		_, _, err = projectService.ValidateConfig(projectService.NewValidateConfigOptions(*project.ID, *config.ID))
+		Expect(err).To(BeNil())
+		_, _, err = projectService.GetConfig(projectService.NewGetConfigOptions(*project.ID, *config.ID))
+		Expect(err).To(BeNil())
+		_, _, err = projectService.Approve(projectService.NewApproveOptions(*project.ID, *config.ID))
+		Expect(err).To(BeNil())
+		_, _, err = projectService.DeployConfig(projectService.NewDeployConfigOptions(*project.ID, *config.ID))
+		Expect(err).To(BeNil())
+
+		logger := core.GetLogger()
+		var buffer bytes.Buffer
+		core.SetLogger(projectv1.NewRedactingLogger(core.NewLogger(core.LevelDebug, log.New(&buffer, "", 0), log.New(&buffer, "", 0))))
+		defer core.SetLogger(logger)
+
+		deployed, _, err := projectService.GetConfig(projectService.NewGetConfigOptions(*project.ID, *config.ID))
+		Expect(err).To(BeNil())
+		Expect(deployed.Outputs[0].Value).To(Equal("root:hunter2"))
+		Expect(buffer.String()).To(ContainSubstring(`"sensitive":true`))
+		Expect(buffer.String()).ToNot(ContainSubstring("hunter2"))
+	})
+})
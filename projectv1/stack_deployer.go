@@ -0,0 +1,546 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/project-go-sdk/common"
+	"github.com/go-openapi/strfmt"
+)
+
+// DefaultStackMaxParallel is the number of stack members that DeployStack deploys at the same time when
+// DeployStackOptions.MaxParallel is not set.
+const DefaultStackMaxParallel = 4
+
+// StackGraph : The dependency graph of the members of a stack configuration.
+// A member depends on the other members of the stack that its definition uses.
+type StackGraph struct {
+	members    []StackMember
+	index      map[string]int
+	dependsOn  map[string][]string
+	dependents map[string][]string
+	order      []string
+}
+
+// StackCycleError : The error returned when the members of a stack configuration depend on each other in a cycle.
+type StackCycleError struct {
+	// The configuration IDs of the members that form the cycle. The first member is repeated at the end.
+	Cycle []string
+}
+
+// Error implements the error interface.
+func (e *StackCycleError) Error() string {
+	return fmt.Sprintf("stack members have a dependency cycle: %s", strings.Join(e.Cycle, " -> "))
+}
+
+// NewStackGraph : Instantiate StackGraph
+// Build the dependency graph of the specified stack members. The dependsOn map lists, by member configuration ID, the
+// configuration IDs that the member depends on; IDs that are not members of the stack are ignored. A
+// StackCycleError is returned if the dependencies form a cycle.
+func NewStackGraph(members []StackMember, dependsOn map[string][]string) (graph *StackGraph, err error) {
+	graph = &StackGraph{
+		index:      make(map[string]int),
+		dependsOn:  make(map[string][]string),
+		dependents: make(map[string][]string),
+	}
+	for _, member := range members {
+		configID := core.StringNilMapper(member.ConfigID)
+		if configID == "" {
+			err = core.SDKErrorf(nil, fmt.Sprintf("stack member '%s' has no configuration ID", core.StringNilMapper(member.Name)), "missing-member-config-id", common.GetComponentInfo())
+			return nil, err
+		}
+		if _, found := graph.index[configID]; found {
+			err = core.SDKErrorf(nil, fmt.Sprintf("configuration '%s' is listed twice in the stack", configID), "duplicate-member", common.GetComponentInfo())
+			return nil, err
+		}
+		graph.index[configID] = len(graph.members)
+		graph.members = append(graph.members, member)
+	}
+	for _, member := range graph.members {
+		configID := *member.ConfigID
+		for _, dependency := range dependsOn[configID] {
+			if _, found := graph.index[dependency]; !found || containsString(graph.dependsOn[configID], dependency) {
+				continue
+			}
+			graph.dependsOn[configID] = append(graph.dependsOn[configID], dependency)
+			graph.dependents[dependency] = append(graph.dependents[dependency], configID)
+		}
+	}
+
+	if cycle := graph.findCycle(); cycle != nil {
+		err = core.SDKErrorf(&StackCycleError{Cycle: cycle}, "", "stack-cycle", common.GetComponentInfo())
+		return nil, err
+	}
+	graph.order = graph.topologicalOrder()
+	return
+}
+
+// Members returns the members of the stack, in the order they were specified.
+func (graph *StackGraph) Members() []StackMember {
+	return graph.members
+}
+
+// Member returns the member of the stack with the specified configuration ID.
+func (graph *StackGraph) Member(configID string) (member StackMember, found bool) {
+	i, found := graph.index[configID]
+	if found {
+		member = graph.members[i]
+	}
+	return
+}
+
+// DependsOn returns the configuration IDs of the members that the specified member depends on.
+func (graph *StackGraph) DependsOn(configID string) []string {
+	return graph.dependsOn[configID]
+}
+
+// Dependents returns the configuration IDs of the members that depend on the specified member.
+func (graph *StackGraph) Dependents(configID string) []string {
+	return graph.dependents[configID]
+}
+
+// Order returns the configuration IDs of the members in an order in which every member comes after its dependencies.
+// Members that do not depend on each other keep the order in which they were specified.
+func (graph *StackGraph) Order() []string {
+	return graph.order
+}
+
+func (graph *StackGraph) topologicalOrder() []string {
+	pending := make(map[string]int)
+	for _, member := range graph.members {
+		pending[*member.ConfigID] = len(graph.dependsOn[*member.ConfigID])
+	}
+	order := make([]string, 0, len(graph.members))
+	done := make(map[string]bool)
+	for len(order) < len(graph.members) {
+		for _, member := range graph.members {
+			configID := *member.ConfigID
+			if done[configID] || pending[configID] > 0 {
+				continue
+			}
+			done[configID] = true
+			order = append(order, configID)
+			for _, dependent := range graph.dependents[configID] {
+				pending[dependent]--
+			}
+			break
+		}
+	}
+	return order
+}
+
+// findCycle returns a dependency cycle between the members, or nil if there is none.
+func (graph *StackGraph) findCycle() []string {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	marks := make(map[string]int)
+	var path []string
+	var visit func(configID string) []string
+	visit = func(configID string) []string {
+		marks[configID] = visiting
+		path = append(path, configID)
+		for _, dependency := range graph.dependsOn[configID] {
+			switch marks[dependency] {
+			case visiting:
+				for i, id := range path {
+					if id == dependency {
+						return append(append([]string{}, path[i:]...), dependency)
+					}
+				}
+			case unvisited:
+				if cycle := visit(dependency); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		marks[configID] = visited
+		return nil
+	}
+	for _, member := range graph.members {
+		if marks[*member.ConfigID] == unvisited {
+			if cycle := visit(*member.ConfigID); cycle != nil {
+				return cycle
+			}
+		}
+	}
+	return nil
+}
+
+// DeployStackOptions : The DeployStack options.
+type DeployStackOptions struct {
+	// The unique project ID.
+	ProjectID *string `json:"project_id" validate:"required,ne="`
+
+	// The unique ID of the stack configuration.
+	ID *string `json:"id" validate:"required,ne="`
+
+	// The maximum number of members that are deployed at the same time. Defaults to DefaultStackMaxParallel.
+	MaxParallel int `json:"max_parallel,omitempty"`
+
+	// The delay between the first polls of a member state. See WaitForConfigStateOptions.PollInterval.
+	PollInterval time.Duration `json:"poll_interval,omitempty"`
+
+	// The upper bound for the delay between polls. See WaitForConfigStateOptions.MaxPollInterval.
+	MaxPollInterval time.Duration `json:"max_poll_interval,omitempty"`
+
+	// The factor by which the delay between polls grows. See WaitForConfigStateOptions.BackoffFactor.
+	BackoffFactor float64 `json:"backoff_factor,omitempty"`
+
+	// The maximum time to wait for each member to deploy. A zero value waits until the context is done.
+	MemberTimeout time.Duration `json:"member_timeout,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewDeployStackOptions : Instantiate DeployStackOptions
+func (*ProjectV1) NewDeployStackOptions(projectID string, id string) *DeployStackOptions {
+	return &DeployStackOptions{
+		ProjectID: core.StringPtr(projectID),
+		ID:        core.StringPtr(id),
+	}
+}
+
+// SetProjectID : Allow user to set ProjectID
+func (_options *DeployStackOptions) SetProjectID(projectID string) *DeployStackOptions {
+	_options.ProjectID = core.StringPtr(projectID)
+	return _options
+}
+
+// SetID : Allow user to set ID
+func (_options *DeployStackOptions) SetID(id string) *DeployStackOptions {
+	_options.ID = core.StringPtr(id)
+	return _options
+}
+
+// SetMaxParallel : Allow user to set MaxParallel
+func (_options *DeployStackOptions) SetMaxParallel(maxParallel int) *DeployStackOptions {
+	_options.MaxParallel = maxParallel
+	return _options
+}
+
+// SetPollInterval : Allow user to set PollInterval
+func (_options *DeployStackOptions) SetPollInterval(pollInterval time.Duration) *DeployStackOptions {
+	_options.PollInterval = pollInterval
+	return _options
+}
+
+// SetMaxPollInterval : Allow user to set MaxPollInterval
+func (_options *DeployStackOptions) SetMaxPollInterval(maxPollInterval time.Duration) *DeployStackOptions {
+	_options.MaxPollInterval = maxPollInterval
+	return _options
+}
+
+// SetBackoffFactor : Allow user to set BackoffFactor
+func (_options *DeployStackOptions) SetBackoffFactor(backoffFactor float64) *DeployStackOptions {
+	_options.BackoffFactor = backoffFactor
+	return _options
+}
+
+// SetMemberTimeout : Allow user to set MemberTimeout
+func (_options *DeployStackOptions) SetMemberTimeout(memberTimeout time.Duration) *DeployStackOptions {
+	_options.MemberTimeout = memberTimeout
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *DeployStackOptions) SetHeaders(param map[string]string) *DeployStackOptions {
+	options.Headers = param
+	return options
+}
+
+// StackDeployment : The outcome of a stack deployment.
+type StackDeployment struct {
+	// The unique project ID.
+	ProjectID string `json:"project_id"`
+
+	// The unique ID of the stack configuration.
+	StackID string `json:"stack_id"`
+
+	// The dependency graph of the stack members.
+	Graph *StackGraph `json:"-"`
+
+	// The results of the members, in deployment order.
+	Members []StackMemberResult `json:"members"`
+}
+
+// Deployed returns true if every member of the stack was deployed.
+func (deployment *StackDeployment) Deployed() bool {
+	for _, member := range deployment.Members {
+		if member.Status != StackMemberResult_Status_Deployed {
+			return false
+		}
+	}
+	return true
+}
+
+// StackMemberResult : The outcome of the deployment of a stack member.
+type StackMemberResult struct {
+	// The name of the member.
+	Name string `json:"name"`
+
+	// The unique configuration ID of the member.
+	ConfigID string `json:"config_id"`
+
+	// The configuration IDs of the members that had to be deployed first.
+	DependsOn []string `json:"depends_on,omitempty"`
+
+	// The outcome of the member deployment.
+	Status string `json:"status"`
+
+	// The member configuration as it was last retrieved.
+	Config *ProjectConfig `json:"config,omitempty"`
+
+	// The reason the member was not deployed.
+	Err error `json:"-"`
+
+	// When the deployment of the member started.
+	StartedAt *strfmt.DateTime `json:"started_at,omitempty"`
+
+	// When the deployment of the member finished.
+	FinishedAt *strfmt.DateTime `json:"finished_at,omitempty"`
+}
+
+// Constants associated with the StackMemberResult.Status property.
+// The outcome of the member deployment.
+const (
+	StackMemberResult_Status_Deployed = "deployed"
+	StackMemberResult_Status_Failed   = "failed"
+	StackMemberResult_Status_Skipped  = "skipped"
+)
+
+// DeployStack : Deploy the members of a stack configuration
+// Build the dependency graph of the stack members from the `members` of the stack definition and the `uses` of each
+// member definition, then deploy the members so that every member is deployed after the members it uses. Members
+// that do not depend on each other are deployed in parallel, up to the MaxParallel option. When a member fails to
+// deploy, the members that depend on it are skipped. The returned deployment reports the outcome of every member;
+// an error is also returned if any member was not deployed.
+func (project *ProjectV1) DeployStack(deployStackOptions *DeployStackOptions) (result *StackDeployment, err error) {
+	result, err = project.DeployStackWithContext(context.Background(), deployStackOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeployStackWithContext is an alternate form of the DeployStack method which supports a Context parameter
+func (project *ProjectV1) DeployStackWithContext(ctx context.Context, deployStackOptions *DeployStackOptions) (result *StackDeployment, err error) {
+	return project.deployStack(ctx, project, deployStackOptions)
+}
+
+// deployStack deploys the members of a stack through the DeployConfigWithContext method of actions.
+func (project *ProjectV1) deployStack(ctx context.Context, actions configActions, deployStackOptions *DeployStackOptions) (result *StackDeployment, err error) {
+	err = core.ValidateNotNil(deployStackOptions, "deployStackOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(deployStackOptions, "deployStackOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	graph, configs, err := project.getStackGraph(ctx, deployStackOptions)
+	if err != nil {
+		return
+	}
+	result = &StackDeployment{
+		ProjectID: *deployStackOptions.ProjectID,
+		StackID:   *deployStackOptions.ID,
+		Graph:     graph,
+	}
+
+	results := make(map[string]*StackMemberResult)
+	for _, configID := range graph.Order() {
+		member, _ := graph.Member(configID)
+		results[configID] = &StackMemberResult{
+			Name:      core.StringNilMapper(member.Name),
+			ConfigID:  configID,
+			DependsOn: graph.DependsOn(configID),
+			Config:    configs[configID],
+		}
+	}
+
+	maxParallel := deployStackOptions.MaxParallel
+	if maxParallel <= 0 {
+		maxParallel = DefaultStackMaxParallel
+	}
+	pending := make(map[string]int)
+	var ready []string
+	for _, configID := range graph.Order() {
+		pending[configID] = len(graph.DependsOn(configID))
+		if pending[configID] == 0 {
+			ready = append(ready, configID)
+		}
+	}
+
+	// skip marks the dependents of a member that was not deployed, and their own dependents, as skipped.
+	var skip func(configID string)
+	skip = func(configID string) {
+		for _, dependent := range graph.Dependents(configID) {
+			if results[dependent].Status == "" {
+				results[dependent].Status = StackMemberResult_Status_Skipped
+				results[dependent].Err = core.SDKErrorf(nil, fmt.Sprintf("stack member '%s' was not deployed", configID), "dependency-not-deployed", common.GetComponentInfo())
+				skip(dependent)
+			}
+		}
+	}
+
+	finished := make(chan *StackMemberResult)
+	running := 0
+	for len(ready) > 0 || running > 0 {
+		for len(ready) > 0 && running < maxParallel && ctx.Err() == nil {
+			memberResult := results[ready[0]]
+			ready = ready[1:]
+			running++
+			go func() {
+				project.deployStackMember(ctx, actions, deployStackOptions, memberResult)
+				finished <- memberResult
+			}()
+		}
+		if running == 0 {
+			break
+		}
+
+		memberResult := <-finished
+		running--
+		if memberResult.Status != StackMemberResult_Status_Deployed {
+			skip(memberResult.ConfigID)
+			continue
+		}
+		for _, dependent := range graph.Dependents(memberResult.ConfigID) {
+			pending[dependent]--
+			if pending[dependent] == 0 && results[dependent].Status == "" {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+
+	notDeployed := 0
+	for _, configID := range graph.Order() {
+		memberResult := results[configID]
+		if memberResult.Status == "" {
+			memberResult.Status = StackMemberResult_Status_Skipped
+			memberResult.Err = core.SDKErrorf(ctx.Err(), "", "stack-deploy-interrupted", common.GetComponentInfo())
+		}
+		if memberResult.Status != StackMemberResult_Status_Deployed {
+			notDeployed++
+		}
+		result.Members = append(result.Members, *memberResult)
+	}
+	if notDeployed > 0 {
+		err = core.SDKErrorf(nil, fmt.Sprintf("%d of %d stack members were not deployed", notDeployed, len(result.Members)), "stack-members-not-deployed", common.GetComponentInfo())
+	}
+	return
+}
+
+// getStackGraph retrieves the stack configuration and its members, and builds the dependency graph of the members.
+func (project *ProjectV1) getStackGraph(ctx context.Context, deployStackOptions *DeployStackOptions) (graph *StackGraph, configs map[string]*ProjectConfig, err error) {
+	stack, _, err := project.GetConfigWithContext(ctx, &GetConfigOptions{
+		ProjectID: deployStackOptions.ProjectID,
+		ID:        deployStackOptions.ID,
+		Headers:   deployStackOptions.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-stack-error")
+		return
+	}
+	definition := definitionResponse(stack.Definition)
+	if definition == nil || len(definition.Members) == 0 {
+		err = core.SDKErrorf(nil, fmt.Sprintf("configuration '%s' has no stack members", *deployStackOptions.ID), "no-stack-members", common.GetComponentInfo())
+		return
+	}
+
+	configs = make(map[string]*ProjectConfig)
+	dependsOn := make(map[string][]string)
+	for _, member := range definition.Members {
+		if member.ConfigID == nil {
+			continue
+		}
+		var config *ProjectConfig
+		config, _, err = project.GetConfigWithContext(ctx, &GetConfigOptions{
+			ProjectID: deployStackOptions.ProjectID,
+			ID:        member.ConfigID,
+			Headers:   deployStackOptions.Headers,
+		})
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "get-stack-member-error")
+			return
+		}
+		configs[*member.ConfigID] = config
+		if memberDefinition := definitionResponse(config.Definition); memberDefinition != nil {
+			for _, uses := range memberDefinition.Uses {
+				if uses.ProjectID != nil && *uses.ProjectID != *deployStackOptions.ProjectID {
+					continue
+				}
+				dependsOn[*member.ConfigID] = append(dependsOn[*member.ConfigID], core.StringNilMapper(uses.ConfigID))
+			}
+		}
+	}
+
+	graph, err = NewStackGraph(definition.Members, dependsOn)
+	return
+}
+
+// deployStackMember deploys a stack member and waits for the deployment to finish.
+func (project *ProjectV1) deployStackMember(ctx context.Context, actions configActions, deployStackOptions *DeployStackOptions, memberResult *StackMemberResult) {
+	startedAt := strfmt.DateTime(time.Now())
+	memberResult.StartedAt = &startedAt
+	defer func() {
+		finishedAt := strfmt.DateTime(time.Now())
+		memberResult.FinishedAt = &finishedAt
+	}()
+
+	configID := core.StringPtr(memberResult.ConfigID)
+	_, _, err := actions.DeployConfigWithContext(ctx, &DeployConfigOptions{
+		ProjectID: deployStackOptions.ProjectID,
+		ID:        configID,
+		Headers:   deployStackOptions.Headers,
+	})
+	if err == nil {
+		var config *ProjectConfig
+		config, _, err = project.WaitForConfigStateWithContext(ctx, &WaitForConfigStateOptions{
+			ProjectID:       deployStackOptions.ProjectID,
+			ID:              configID,
+			TargetStates:    []string{ProjectConfig_State_Deployed},
+			PollInterval:    deployStackOptions.PollInterval,
+			MaxPollInterval: deployStackOptions.MaxPollInterval,
+			BackoffFactor:   deployStackOptions.BackoffFactor,
+			Timeout:         deployStackOptions.MemberTimeout,
+			Headers:         deployStackOptions.Headers,
+		})
+		if config != nil {
+			memberResult.Config = config
+		} else if last := lastWaitedConfig(err); last != nil {
+			memberResult.Config = last
+		}
+	}
+	if err != nil {
+		memberResult.Status = StackMemberResult_Status_Failed
+		memberResult.Err = err
+		return
+	}
+	memberResult.Status = StackMemberResult_Status_Deployed
+}
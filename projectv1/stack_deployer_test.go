@@ -0,0 +1,246 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/project-go-sdk/projectv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`StackGraph`, func() {
+	members := []projectv1.StackMember{
+		{Name: core.StringPtr("app"), ConfigID: core.StringPtr("c-app")},
+		{Name: core.StringPtr("network"), ConfigID: core.StringPtr("c-network")},
+		{Name: core.StringPtr("database"), ConfigID: core.StringPtr("c-database")},
+	}
+
+	It(`Orders members after their dependencies`, func() {
+		graph, err := projectv1.NewStackGraph(members, map[string][]string{
+			"c-app":      {"c-database", "c-network", "c-outside"},
+			"c-database": {"c-network"},
+		})
+		Expect(err).To(BeNil())
+		Expect(graph.Order()).To(Equal([]string{"c-network", "c-database", "c-app"}))
+		Expect(graph.DependsOn("c-app")).To(Equal([]string{"c-database", "c-network"}))
+		Expect(graph.Dependents("c-network")).To(ConsistOf("c-app", "c-database"))
+		member, found := graph.Member("c-database")
+		Expect(found).To(BeTrue())
+		Expect(*member.Name).To(Equal("database"))
+		Expect(graph.Members()).To(Equal(members))
+	})
+	It(`Keeps the specified order of independent members`, func() {
+		graph, err := projectv1.NewStackGraph(members, nil)
+		Expect(err).To(BeNil())
+		Expect(graph.Order()).To(Equal([]string{"c-app", "c-network", "c-database"}))
+	})
+	It(`Detects dependency cycles`, func() {
+		_, err := projectv1.NewStackGraph(members, map[string][]string{
+			"c-app":      {"c-database"},
+			"c-database": {"c-network"},
+			"c-network":  {"c-app"},
+		})
+		var cycleErr *projectv1.StackCycleError
+		Expect(errors.As(err, &cycleErr)).To(BeTrue())
+		Expect(cycleErr.Cycle).To(Equal([]string{"c-app", "c-database", "c-network", "c-app"}))
+		Expect(err.Error()).To(ContainSubstring("c-app -> c-database -> c-network -> c-app"))
+	})
+	It(`Rejects duplicate members`, func() {
+		_, err := projectv1.NewStackGraph(append(members, members[0]), nil)
+		Expect(err).ToNot(BeNil())
+	})
+})
+
+var _ = Describe(`DeployStack`, func() {
+	var testServer *httptest.Server
+	var projectService *projectv1.ProjectV1
+
+	// stackServer serves a stack configuration "stack" whose members use each other as listed in uses. Deploying a
+	// member finishes on the next GetConfig, with a failure for the members listed in failing.
+	type stackServer struct {
+		sync.Mutex
+		uses       map[string][]string
+		failing    map[string]bool
+		states     map[string]string
+		deployed   []string
+		running    int
+		maxRunning int
+	}
+	var server *stackServer
+
+	startServer := func(s *stackServer) {
+		server = s
+		server.states = make(map[string]string)
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			server.Lock()
+			defer server.Unlock()
+
+			path := strings.TrimPrefix(req.URL.EscapedPath(), "/v1/projects/testString/configs/")
+			configID := strings.Split(path, "/")[0]
+			res.Header().Set("Content-type", "application/json")
+			if configID == "stack" {
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{"id": "stack", "state": "approved", "definition": {"members": [{"name": "app", "config_id": "c-app"}, {"name": "network", "config_id": "c-network"}, {"name": "database", "config_id": "c-database"}]}}`)
+				return
+			}
+			switch {
+			case req.Method == "POST" && strings.HasSuffix(path, "/deploy"):
+				for _, dependency := range server.uses[configID] {
+					Expect(server.states[dependency]).To(Equal(projectv1.ProjectConfig_State_Deployed))
+				}
+				server.states[configID] = projectv1.ProjectConfig_State_Deploying
+				server.running++
+				if server.running > server.maxRunning {
+					server.maxRunning = server.running
+				}
+			case req.Method == "GET" && server.states[configID] == projectv1.ProjectConfig_State_Deploying:
+				server.running--
+				if server.failing[configID] {
+					server.states[configID] = projectv1.ProjectConfig_State_DeployingFailed
+				} else {
+					server.states[configID] = projectv1.ProjectConfig_State_Deployed
+					server.deployed = append(server.deployed, configID)
+				}
+			}
+			var uses []string
+			for _, dependency := range server.uses[configID] {
+				uses = append(uses, fmt.Sprintf(`{"config_id": "%s", "project_id": "testString"}`, dependency))
+			}
+			uses = append(uses, `{"config_id": "c-elsewhere", "project_id": "otherProject"}`)
+			state := server.states[configID]
+			if state == "" {
+				state = projectv1.ProjectConfig_State_Approved
+			}
+			res.WriteHeader(200)
+			fmt.Fprintf(res, `{"id": "%s", "state": "%s", "definition": {"uses": [%s]}}`, configID, state, strings.Join(uses, ", "))
+		}))
+		var serviceErr error
+		projectService, serviceErr = projectv1.NewProjectV1(&projectv1.ProjectV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	}
+
+	newOptions := func() *projectv1.DeployStackOptions {
+		return projectService.NewDeployStackOptions("testString", "stack").
+			SetPollInterval(time.Millisecond).
+			SetMaxPollInterval(time.Millisecond)
+	}
+
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Deploys members in dependency order`, func() {
+		startServer(&stackServer{uses: map[string][]string{
+			"c-app":      {"c-database", "c-network"},
+			"c-database": {"c-network"},
+		}})
+
+		deployment, err := projectService.DeployStack(newOptions())
+		Expect(err).To(BeNil())
+		Expect(deployment.Deployed()).To(BeTrue())
+		Expect(deployment.StackID).To(Equal("stack"))
+		Expect(server.deployed).To(Equal([]string{"c-network", "c-database", "c-app"}))
+		Expect(deployment.Members).To(HaveLen(3))
+		Expect(deployment.Members[2].Name).To(Equal("app"))
+		Expect(deployment.Members[2].DependsOn).To(Equal([]string{"c-database", "c-network"}))
+		Expect(*deployment.Members[2].Config.State).To(Equal(projectv1.ProjectConfig_State_Deployed))
+		Expect(time.Time(*deployment.Members[2].StartedAt).After(time.Time(*deployment.Members[0].FinishedAt))).To(BeTrue())
+	})
+	It(`Deploys independent members with bounded parallelism`, func() {
+		startServer(&stackServer{})
+
+		deployment, err := projectService.DeployStack(newOptions().SetMaxParallel(2))
+		Expect(err).To(BeNil())
+		Expect(deployment.Deployed()).To(BeTrue())
+		Expect(server.deployed).To(ConsistOf("c-app", "c-network", "c-database"))
+		Expect(server.maxRunning).To(BeNumerically("<=", 2))
+	})
+	It(`Skips the members that depend on a failed member`, func() {
+		startServer(&stackServer{
+			uses: map[string][]string{
+				"c-app":      {"c-database"},
+				"c-database": {"c-network"},
+			},
+			failing: map[string]bool{"c-network": true},
+		})
+
+		deployment, err := projectService.DeployStack(newOptions())
+		Expect(err).ToNot(BeNil())
+		Expect(deployment.Deployed()).To(BeFalse())
+		Expect(server.deployed).To(BeEmpty())
+		Expect(deployment.Members[0].ConfigID).To(Equal("c-network"))
+		Expect(deployment.Members[0].Status).To(Equal(projectv1.StackMemberResult_Status_Failed))
+		var stateErr *projectv1.ConfigStateError
+		Expect(errors.As(deployment.Members[0].Err, &stateErr)).To(BeTrue())
+		Expect(deployment.Members[1].Status).To(Equal(projectv1.StackMemberResult_Status_Skipped))
+		Expect(deployment.Members[2].Status).To(Equal(projectv1.StackMemberResult_Status_Skipped))
+		Expect(deployment.Members[2].StartedAt).To(BeNil())
+		data, err := json.Marshal(deployment.Members[2])
+		Expect(err).To(BeNil())
+		Expect(string(data)).ToNot(ContainSubstring("started_at"))
+	})
+	It(`Does not deploy members that depend on each other in a cycle`, func() {
+		startServer(&stackServer{uses: map[string][]string{
+			"c-app":     {"c-network"},
+			"c-network": {"c-app"},
+		}})
+
+		deployment, err := projectService.DeployStack(newOptions())
+		Expect(deployment).To(BeNil())
+		var cycleErr *projectv1.StackCycleError
+		Expect(errors.As(err, &cycleErr)).To(BeTrue())
+		Expect(server.states).To(BeEmpty())
+	})
+	It(`Invoke DeployStack with error: Param validation error`, func() {
+		startServer(&stackServer{})
+
+		_, err := projectService.DeployStack(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = projectService.DeployStack(new(projectv1.DeployStackOptions))
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Invoke NewDeployStackOptions successfully`, func() {
+		startServer(&stackServer{})
+
+		options := projectService.NewDeployStackOptions("testString", "testString")
+		options.SetProjectID("projectID").SetID("stackID").SetMaxParallel(3).SetPollInterval(time.Second).
+			SetMaxPollInterval(time.Minute).SetBackoffFactor(2).SetMemberTimeout(time.Hour).
+			SetHeaders(map[string]string{"foo": "bar"})
+		Expect(options.ProjectID).To(Equal(core.StringPtr("projectID")))
+		Expect(options.ID).To(Equal(core.StringPtr("stackID")))
+		Expect(options.MaxParallel).To(Equal(3))
+		Expect(options.PollInterval).To(Equal(time.Second))
+		Expect(options.MaxPollInterval).To(Equal(time.Minute))
+		Expect(options.BackoffFactor).To(Equal(2.0))
+		Expect(options.MemberTimeout).To(Equal(time.Hour))
+		Expect(options.Headers).To(Equal(map[string]string{"foo": "bar"}))
+	})
+})
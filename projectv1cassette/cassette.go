@@ -0,0 +1,379 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package projectv1cassette records the HTTP interactions of a projectv1.ProjectV1 client into a cassette file and
+// replays them offline.
+//
+// A Transport in record mode sends the requests of the client to the service and keeps each request with its response.
+// Save writes them to the cassette file, with credentials redacted: the Authorization header, the api_key of
+// configuration and environment authorizations, and the token of the project definition store. A Transport in replay
+// mode answers each request with the next recorded response for the same method and URL path and query, without any
+// network access, so a recorded session can be replayed as a deterministic test.
+package projectv1cassette
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+
+	"github.com/IBM/project-go-sdk/projectv1"
+)
+
+// Mode is the mode of a Transport.
+type Mode int
+
+// Constants for the modes of a Transport.
+const (
+	ModeRecord Mode = iota
+	ModeReplay
+)
+
+// Redacted is the value that replaces credentials in a cassette.
+const Redacted = "REDACTED"
+
+// redactedHeaders are the request and response headers whose values are redacted.
+var redactedHeaders = []string{"Authorization", "Cookie", "Set-Cookie", "X-Auth-Refresh-Token"}
+
+// redactedProperties maps the JSON properties that hold credentials to the property of the object that contains
+// them: the api_key of a ProjectConfigAuth and the token of a ProjectDefinitionStore.
+var redactedProperties = map[string]string{
+	"api_key": "authorizations",
+	"token":   "store",
+}
+
+// Cassette : The recorded interactions of a session, in the order of their requests.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction : A request and the response that the service returned for it.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request : A recorded request.
+type Request struct {
+	Method  string      `json:"method"`
+	URL     string      `json:"url"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+// Response : A recorded response.
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// LoadCassette : Read a cassette file.
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	cassette := new(Cassette)
+	if err := json.Unmarshal(data, cassette); err != nil {
+		return nil, fmt.Errorf("cassette %s is not valid: %w", path, err)
+	}
+	return cassette, nil
+}
+
+// Save : Write a cassette file, with the credentials of the interactions redacted.
+func (cassette *Cassette) Save(path string) error {
+	redacted := &Cassette{Interactions: make([]Interaction, len(cassette.Interactions))}
+	for i, interaction := range cassette.Interactions {
+		redacted.Interactions[i] = redactInteraction(interaction)
+	}
+	data, err := json.MarshalIndent(redacted, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0600)
+}
+
+// Transport : An http.RoundTripper that records interactions into a cassette or replays them from it.
+type Transport struct {
+	// The transport that sends the requests in record mode. If nil, http.DefaultTransport is used.
+	Next http.RoundTripper
+
+	path     string
+	mode     Mode
+	mutex    sync.Mutex
+	cassette *Cassette
+	replayed []bool
+}
+
+// NewTransport : Create a Transport for a cassette file.
+// In replay mode the cassette file is read and must exist. In record mode the cassette starts empty, and Save writes
+// the file.
+func NewTransport(path string, mode Mode) (*Transport, error) {
+	transport := &Transport{
+		path:     path,
+		mode:     mode,
+		cassette: new(Cassette),
+	}
+	if mode == ModeReplay {
+		cassette, err := LoadCassette(path)
+		if err != nil {
+			return nil, err
+		}
+		transport.cassette = cassette
+		transport.replayed = make([]bool, len(cassette.Interactions))
+	}
+	return transport, nil
+}
+
+// Install : Make a ProjectV1 client send its requests through the transport.
+// In record mode, the transport of the client is used to send the requests unless Next is set.
+func (transport *Transport) Install(service *projectv1.ProjectV1) {
+	client := *service.Service.GetHTTPClient()
+	if transport.Next == nil {
+		transport.Next = client.Transport
+	}
+	client.Transport = transport
+	service.Service.SetHTTPClient(&client)
+}
+
+// Unwrap : Return the transport that sends the requests in record mode.
+func (transport *Transport) Unwrap() http.RoundTripper {
+	return transport.Next
+}
+
+// Cassette : Return a copy of the interactions that the transport holds.
+func (transport *Transport) Cassette() *Cassette {
+	transport.mutex.Lock()
+	defer transport.mutex.Unlock()
+
+	return &Cassette{Interactions: append([]Interaction(nil), transport.cassette.Interactions...)}
+}
+
+// Save : Write the recorded interactions to the cassette file.
+func (transport *Transport) Save() error {
+	return transport.Cassette().Save(transport.path)
+}
+
+// Remaining : Return the number of recorded interactions that have not been replayed.
+func (transport *Transport) Remaining() int {
+	transport.mutex.Lock()
+	defer transport.mutex.Unlock()
+
+	remaining := 0
+	for _, replayed := range transport.replayed {
+		if !replayed {
+			remaining++
+		}
+	}
+	return remaining
+}
+
+// RoundTrip : Record or replay the interaction of a request.
+func (transport *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if transport.mode == ModeReplay {
+		return transport.replay(req)
+	}
+	return transport.record(req)
+}
+
+func (transport *Transport) record(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	outgoing := req.Clone(req.Context())
+	if req.Body != nil {
+		outgoing.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	if req.Header.Get("Content-Encoding") == "gzip" {
+		if body, err = gunzip(body); err != nil {
+			return nil, err
+		}
+	}
+
+	next := transport.Next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	res, err := next.RoundTrip(outgoing)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	interaction := redactInteraction(Interaction{
+		Request: Request{
+			Method:  req.Method,
+			URL:     req.URL.String(),
+			Headers: req.Header.Clone(),
+			Body:    string(body),
+		},
+		Response: Response{
+			StatusCode: res.StatusCode,
+			Headers:    res.Header.Clone(),
+			Body:       string(responseBody),
+		},
+	})
+	transport.mutex.Lock()
+	transport.cassette.Interactions = append(transport.cassette.Interactions, interaction)
+	transport.mutex.Unlock()
+	return res, nil
+}
+
+// replay returns the response of the first interaction that has not been replayed and whose request has the method,
+// path and query of the request.
+func (transport *Transport) replay(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+
+	transport.mutex.Lock()
+	defer transport.mutex.Unlock()
+
+	for i, interaction := range transport.cassette.Interactions {
+		if transport.replayed[i] || interaction.Request.Method != req.Method {
+			continue
+		}
+		recordedURL, err := url.Parse(interaction.Request.URL)
+		if err != nil || recordedURL.RequestURI() != req.URL.RequestURI() {
+			continue
+		}
+		transport.replayed[i] = true
+
+		recorded := interaction.Response
+		header := recorded.Headers.Clone()
+		if header == nil {
+			header = make(http.Header)
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+			StatusCode:    recorded.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(bytes.NewReader([]byte(recorded.Body))),
+			ContentLength: int64(len(recorded.Body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("cassette %s has no interaction left for %s %s", transport.path, req.Method, req.URL.RequestURI())
+}
+
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	defer req.Body.Close()
+	return io.ReadAll(req.Body)
+}
+
+func gunzip(data []byte) ([]byte, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return io.ReadAll(reader)
+}
+
+// redactInteraction returns a copy of an interaction with its credentials redacted.
+func redactInteraction(interaction Interaction) Interaction {
+	interaction.Request.Headers = redactHeaders(interaction.Request.Headers)
+	interaction.Request.Body = redactBody(interaction.Request.Body)
+	interaction.Response.Headers = redactHeaders(interaction.Response.Headers)
+	interaction.Response.Body = redactBody(interaction.Response.Body)
+	// The length of a redacted body differs from the recorded length.
+	interaction.Request.Headers.Del("Content-Length")
+	interaction.Response.Headers.Del("Content-Length")
+	interaction.Request.Headers.Del("Content-Encoding")
+	return interaction
+}
+
+func redactHeaders(headers http.Header) http.Header {
+	redacted := headers.Clone()
+	if redacted == nil {
+		redacted = make(http.Header)
+	}
+	for _, name := range redactedHeaders {
+		if values := redacted.Values(name); len(values) > 0 {
+			redacted.Del(name)
+			for range values {
+				redacted.Add(name, Redacted)
+			}
+		}
+	}
+	return redacted
+}
+
+// redactBody redacts the credentials of a JSON body. A body that is not JSON is returned as is.
+func redactBody(body string) string {
+	if body == "" {
+		return body
+	}
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader([]byte(body)))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return body
+	}
+	if !redactValue(value, "") {
+		return body
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return body
+	}
+	return string(data)
+}
+
+// redactValue redacts the credentials of a decoded JSON value in place, and reports whether it redacted any.
+// The parent is the name of the property that holds the value.
+func redactValue(value interface{}, parent string) (redacted bool) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for name, property := range v {
+			if container, found := redactedProperties[name]; found && container == parent {
+				if _, isString := property.(string); isString {
+					v[name] = Redacted
+					redacted = true
+					continue
+				}
+			}
+			if redactValue(property, name) {
+				redacted = true
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			if redactValue(item, parent) {
+				redacted = true
+			}
+		}
+	}
+	return redacted
+}
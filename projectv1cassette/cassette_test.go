@@ -0,0 +1,137 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1cassette_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/project-go-sdk/projectv1"
+	"github.com/IBM/project-go-sdk/projectv1cassette"
+	"github.com/IBM/project-go-sdk/projectv1fake"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Transport`, func() {
+	var server *projectv1fake.Server
+	var cassettePath string
+
+	BeforeEach(func() {
+		server = projectv1fake.NewServer()
+		dir, err := os.MkdirTemp("", "cassette")
+		Expect(err).To(BeNil())
+		cassettePath = filepath.Join(dir, "session.json")
+	})
+	AfterEach(func() {
+		server.Close()
+		os.RemoveAll(filepath.Dir(cassettePath))
+	})
+
+	newService := func(transport *projectv1cassette.Transport) *projectv1.ProjectV1 {
+		projectService, err := projectv1.NewProjectV1(&projectv1.ProjectV1Options{
+			URL:           server.URL,
+			Authenticator: &core.BearerTokenAuthenticator{BearerToken: "secret-bearer-token"},
+		})
+		Expect(err).To(BeNil())
+		transport.Install(projectService)
+		return projectService
+	}
+
+	// runSession creates a project with a store token and a configuration with an API key, and gets the configuration.
+	runSession := func(projectService *projectv1.ProjectV1) (*projectv1.ProjectConfig, error) {
+		project, _, err := projectService.CreateProject(projectService.NewCreateProjectOptions(
+			&projectv1.ProjectPrototypeDefinition{
+				Name: core.StringPtr("my-project"),
+				Store: &projectv1.ProjectDefinitionStore{
+					Type:  core.StringPtr("gh"),
+					URL:   core.StringPtr("https://github.com/example/configs"),
+					Token: core.StringPtr("secret-store-token"),
+				},
+			}, "us-south", "Default"))
+		if err != nil {
+			return nil, err
+		}
+		config, _, err := projectService.CreateConfig(projectService.NewCreateConfigOptions(*project.ID,
+			&projectv1.ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype{
+				Name: core.StringPtr("my-config"),
+				Authorizations: &projectv1.ProjectConfigAuth{
+					Method: core.StringPtr("api_key"),
+					ApiKey: core.StringPtr("secret-api-key"),
+				},
+			}))
+		if err != nil {
+			return nil, err
+		}
+		config, _, err = projectService.GetConfig(projectService.NewGetConfigOptions(*project.ID, *config.ID))
+		return config, err
+	}
+
+	It(`Records a session with redacted credentials and replays it`, func() {
+		recorder, err := projectv1cassette.NewTransport(cassettePath, projectv1cassette.ModeRecord)
+		Expect(err).To(BeNil())
+		recorded, err := runSession(newService(recorder))
+		Expect(err).To(BeNil())
+		Expect(recorder.Cassette().Interactions).To(HaveLen(3))
+		Expect(recorder.Save()).To(Succeed())
+
+		data, err := os.ReadFile(cassettePath)
+		Expect(err).To(BeNil())
+		Expect(string(data)).ToNot(ContainSubstring("secret-bearer-token"))
+		Expect(string(data)).ToNot(ContainSubstring("secret-store-token"))
+		Expect(string(data)).ToNot(ContainSubstring("secret-api-key"))
+		Expect(string(data)).To(ContainSubstring("https://github.com/example/configs"))
+
+		cassette, err := projectv1cassette.LoadCassette(cassettePath)
+		Expect(err).To(BeNil())
+		Expect(cassette.Interactions[0].Request.Headers.Get("Authorization")).To(Equal(projectv1cassette.Redacted))
+		Expect(cassette.Interactions[1].Response.StatusCode).To(Equal(201))
+
+		server.Close()
+		replayer, err := projectv1cassette.NewTransport(cassettePath, projectv1cassette.ModeReplay)
+		Expect(err).To(BeNil())
+		replayed, err := runSession(newService(replayer))
+		Expect(err).To(BeNil())
+		Expect(replayer.Remaining()).To(Equal(0))
+		Expect(*replayed.ID).To(Equal(*recorded.ID))
+		Expect(*replayed.State).To(Equal(*recorded.State))
+		definition := replayed.Definition.(*projectv1.ProjectConfigDefinitionResponse)
+		Expect(*definition.Authorizations.ApiKey).To(Equal(projectv1cassette.Redacted))
+	})
+	It(`Fails requests that were not recorded`, func() {
+		recorder, err := projectv1cassette.NewTransport(cassettePath, projectv1cassette.ModeRecord)
+		Expect(err).To(BeNil())
+		projectService := newService(recorder)
+		_, _, err = projectService.ListProjects(projectService.NewListProjectsOptions())
+		Expect(err).To(BeNil())
+		Expect(recorder.Save()).To(Succeed())
+
+		replayer, err := projectv1cassette.NewTransport(cassettePath, projectv1cassette.ModeReplay)
+		Expect(err).To(BeNil())
+		projectService = newService(replayer)
+		_, _, err = projectService.ListProjects(projectService.NewListProjectsOptions())
+		Expect(err).To(BeNil())
+		_, _, err = projectService.ListProjects(projectService.NewListProjectsOptions())
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("no interaction left for GET /v1/projects"))
+	})
+	It(`Fails to replay a missing cassette`, func() {
+		_, err := projectv1cassette.NewTransport(cassettePath, projectv1cassette.ModeReplay)
+		Expect(err).ToNot(BeNil())
+	})
+})
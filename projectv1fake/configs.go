@@ -0,0 +1,772 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/project-go-sdk/projectv1"
+)
+
+// config is the state of a configuration. The versions are in ascending order, and the last one is the version that
+// is returned for the configuration.
+type config struct {
+	id              string
+	project         *project
+	versions        []*projectv1.ProjectConfigVersion
+	approvedVersion *projectv1.ProjectConfigVersion
+	deployedVersion *projectv1.ProjectConfigVersion
+	stackDefinition *projectv1.StackDefinition
+	job             *job
+	prevalidations  map[string]*prevalidation
+}
+
+// job is a validating, deploying or undeploying job that runs for a version of a configuration.
+type job struct {
+	id       string
+	action   string
+	version  *projectv1.ProjectConfigVersion
+	finishAt time.Time
+	fail     bool
+}
+
+// prevalidation is the state of a prevalidation request.
+type prevalidation struct {
+	jobID    string
+	finishAt time.Time
+	fail     bool
+}
+
+// jobStates holds the state of a version while a job of an action runs, after it succeeds and after it fails.
+var jobStates = map[string][3]string{
+	ActionValidate: {projectv1.ProjectConfig_State_Validating, projectv1.ProjectConfig_State_Validated, projectv1.ProjectConfig_State_ValidatingFailed},
+	ActionDeploy:   {projectv1.ProjectConfig_State_Deploying, projectv1.ProjectConfig_State_Deployed, projectv1.ProjectConfig_State_DeployingFailed},
+	ActionUndeploy: {projectv1.ProjectConfig_State_Undeploying, projectv1.ProjectConfig_State_Approved, projectv1.ProjectConfig_State_UndeployingFailed},
+}
+
+func (c *config) current() *projectv1.ProjectConfigVersion {
+	return c.versions[len(c.versions)-1]
+}
+
+// definitionOf returns the definition of a version, which the server always stores as a
+// ProjectConfigDefinitionResponse.
+func definitionOf(version *projectv1.ProjectConfigVersion) *projectv1.ProjectConfigDefinitionResponse {
+	return version.Definition.(*projectv1.ProjectConfigDefinitionResponse)
+}
+
+func (server *Server) findConfig(projectID string, id string) (*config, *apiError) {
+	p, apiErr := server.findProject(projectID)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	for _, c := range p.configs {
+		if c.id == id {
+			return c, nil
+		}
+	}
+	return nil, notFound("configuration", id)
+}
+
+// findIdleConfig returns a configuration that has no running job.
+func (server *Server) findIdleConfig(projectID string, id string) (*config, *apiError) {
+	c, apiErr := server.findConfig(projectID, id)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	if c.job != nil {
+		return nil, conflict("The configuration %s has a running %s job.", id, c.job.action)
+	}
+	return c, nil
+}
+
+func (server *Server) newConfig(p *project, body map[string]json.RawMessage) (*config, *apiError) {
+	var definition *projectv1.ProjectConfigDefinitionResponse
+	if apiErr := unmarshalProperty(body, "definition", &definition, projectv1.UnmarshalProjectConfigDefinitionResponse); apiErr != nil {
+		return nil, apiErr
+	}
+	if definition == nil || definition.Name == nil {
+		return nil, badRequest("The configuration definition must have a name.")
+	}
+	var schematics *projectv1.SchematicsMetadata
+	if apiErr := unmarshalProperty(body, "schematics", &schematics, projectv1.UnmarshalSchematicsMetadata); apiErr != nil {
+		return nil, apiErr
+	}
+
+	deploymentModel := projectv1.ProjectConfig_DeploymentModel_ProjectDeployed
+	if len(definition.Members) > 0 {
+		deploymentModel = projectv1.ProjectConfig_DeploymentModel_Stack
+	} else if len(definition.ResourceCrns) > 0 {
+		deploymentModel = projectv1.ProjectConfig_DeploymentModel_UserDeployed
+	}
+	c := &config{
+		id:             server.newID(),
+		project:        p,
+		prevalidations: make(map[string]*prevalidation),
+	}
+	timestamp := now()
+	c.versions = []*projectv1.ProjectConfigVersion{{
+		ID:                  core.StringPtr(c.id),
+		Version:             core.Int64Ptr(1),
+		NeedsAttentionState: []projectv1.ProjectConfigNeedsAttentionState{},
+		CreatedAt:           timestamp,
+		ModifiedAt:          timestamp,
+		Outputs:             []projectv1.OutputValue{},
+		Href:                server.versionHref(c, 1),
+		IsDraft:             core.BoolPtr(true),
+		LastSavedAt:         timestamp,
+		Project:             server.projectReference(p),
+		Schematics:          schematics,
+		State:               core.StringPtr(projectv1.ProjectConfig_State_Draft),
+		DeploymentModel:     core.StringPtr(deploymentModel),
+		Definition:          definition,
+	}}
+	return c, nil
+}
+
+func versionSummary(version *projectv1.ProjectConfigVersion) *projectv1.ProjectConfigVersionSummary {
+	if version == nil {
+		return nil
+	}
+	definition := definitionOf(version)
+	return &projectv1.ProjectConfigVersionSummary{
+		Definition: &projectv1.ProjectConfigVersionDefinitionSummary{
+			EnvironmentID: definition.EnvironmentID,
+			LocatorID:     definition.LocatorID,
+		},
+		State:   version.State,
+		Version: version.Version,
+		Href:    version.Href,
+	}
+}
+
+func (server *Server) configHref(c *config) *string {
+	return server.href("/v1/projects/%s/configs/%s", c.project.id, c.id)
+}
+
+func (server *Server) versionHref(c *config, version int64) *string {
+	return server.href("/v1/projects/%s/configs/%s/versions/%d", c.project.id, c.id, version)
+}
+
+func (server *Server) configSummary(c *config) *projectv1.ProjectConfigSummary {
+	version := c.current()
+	definition := definitionOf(version)
+	return &projectv1.ProjectConfigSummary{
+		ApprovedVersion: versionSummary(c.approvedVersion),
+		DeployedVersion: versionSummary(c.deployedVersion),
+		ID:              version.ID,
+		Version:         version.Version,
+		State:           version.State,
+		StateCode:       version.StateCode,
+		CreatedAt:       version.CreatedAt,
+		ModifiedAt:      version.ModifiedAt,
+		Href:            server.configHref(c),
+		Definition: &projectv1.ProjectConfigSummaryDefinition{
+			Description: definition.Description,
+			Name:        definition.Name,
+			LocatorID:   definition.LocatorID,
+		},
+		Project:         version.Project,
+		DeploymentModel: version.DeploymentModel,
+	}
+}
+
+func (server *Server) configModel(c *config) *projectv1.ProjectConfig {
+	version := c.current()
+	needsAttention := append(append([]projectv1.ProjectConfigNeedsAttentionState{}, version.NeedsAttentionState...), server.needsAttention[c.id]...)
+	references := version.References
+	if resolved, found := server.references[c.id]; found {
+		references = resolved
+	}
+	return &projectv1.ProjectConfig{
+		ID:                  version.ID,
+		Version:             version.Version,
+		NeedsAttentionState: needsAttention,
+		CreatedAt:           version.CreatedAt,
+		ModifiedAt:          version.ModifiedAt,
+		Outputs:             version.Outputs,
+		References:          references,
+		StateCode:           version.StateCode,
+		ConfigError:         version.ConfigError,
+		Href:                server.configHref(c),
+		IsDraft:             version.IsDraft,
+		LastApproved:        version.LastApproved,
+		LastSavedAt:         version.LastSavedAt,
+		LastValidated:       version.LastValidated,
+		LastDeployed:        version.LastDeployed,
+		LastUndeployed:      version.LastUndeployed,
+		LastMonitoring:      server.monitoring[c.id],
+		Project:             version.Project,
+		Schematics:          version.Schematics,
+		State:               version.State,
+		DeploymentModel:     version.DeploymentModel,
+		Definition:          version.Definition,
+		ApprovedVersion:     versionSummary(c.approvedVersion),
+		DeployedVersion:     versionSummary(c.deployedVersion),
+	}
+}
+
+func (server *Server) createConfig(req *http.Request, params []string) (interface{}, *apiError) {
+	p, apiErr := server.findProject(params[0])
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	body, apiErr := decodeBody(req)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	c, apiErr := server.newConfig(p, body)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	p.configs = append(p.configs, c)
+	return server.configModel(c), nil
+}
+
+func (server *Server) listConfigs(req *http.Request, params []string) (interface{}, *apiError) {
+	p, apiErr := server.findProject(params[0])
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	page, limit, first, next, apiErr := paginate(server, req, p.configs)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	configs := []projectv1.ProjectConfigSummary{}
+	for _, c := range page {
+		configs = append(configs, *server.configSummary(c))
+	}
+	return &projectv1.ProjectConfigCollection{
+		Limit:   core.Int64Ptr(limit),
+		First:   first,
+		Next:    next,
+		Configs: configs,
+	}, nil
+}
+
+func (server *Server) getConfig(req *http.Request, params []string) (interface{}, *apiError) {
+	c, apiErr := server.findConfig(params[0], params[1])
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	return server.configModel(c), nil
+}
+
+// updateConfig updates the draft version of a configuration, and creates a draft version from the last version when
+// that version is no longer a draft.
+func (server *Server) updateConfig(req *http.Request, params []string) (interface{}, *apiError) {
+	c, apiErr := server.findIdleConfig(params[0], params[1])
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	body, apiErr := decodeBody(req)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	version := c.current()
+	var definition *projectv1.ProjectConfigDefinitionResponse
+	if apiErr = patchModel(version.Definition, orNull(body["definition"]), &definition, projectv1.UnmarshalProjectConfigDefinitionResponse); apiErr != nil {
+		return nil, apiErr
+	}
+
+	timestamp := now()
+	if !*version.IsDraft {
+		draft := *version
+		draft.Version = core.Int64Ptr(*version.Version + 1)
+		draft.CreatedAt = timestamp
+		draft.Href = server.versionHref(c, *draft.Version)
+		draft.IsDraft = core.BoolPtr(true)
+		draft.ConfigError = nil
+		draft.LastApproved = nil
+		draft.LastValidated = nil
+		draft.LastDeployed = nil
+		draft.LastUndeployed = nil
+		c.versions = append(c.versions, &draft)
+		version = &draft
+	}
+	version.Definition = definition
+	version.State = core.StringPtr(projectv1.ProjectConfig_State_Draft)
+	version.ModifiedAt = timestamp
+	version.LastSavedAt = timestamp
+	return server.configModel(c), nil
+}
+
+func (server *Server) deleteConfig(req *http.Request, params []string) (interface{}, *apiError) {
+	c, apiErr := server.findIdleConfig(params[0], params[1])
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	if c.deployedVersion != nil {
+		return nil, conflict("The configuration %s is deployed and must be undeployed before it is deleted.", c.id)
+	}
+	p := c.project
+	for i := range p.configs {
+		if p.configs[i] == c {
+			p.configs = append(p.configs[:i], p.configs[i+1:]...)
+			break
+		}
+	}
+	return &projectv1.ProjectConfigDelete{ID: core.StringPtr(c.id)}, nil
+}
+
+func (server *Server) approve(req *http.Request, params []string) (interface{}, *apiError) {
+	return server.approveConfig(req, params, false)
+}
+
+func (server *Server) forceApprove(req *http.Request, params []string) (interface{}, *apiError) {
+	return server.approveConfig(req, params, true)
+}
+
+// approveConfig approves the draft version of a configuration after it is validated. A forced approval also accepts a
+// version whose validation failed, and requires a comment.
+func (server *Server) approveConfig(req *http.Request, params []string, force bool) (interface{}, *apiError) {
+	c, apiErr := server.findIdleConfig(params[0], params[1])
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	body, apiErr := decodeBody(req)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	var comment *string
+	if json.Unmarshal(orNull(body["comment"]), &comment) != nil {
+		return nil, badRequest("The comment must be a string.")
+	}
+	if force && (comment == nil || *comment == "") {
+		return nil, badRequest("A comment is required to force the approval of a configuration.")
+	}
+
+	version := c.current()
+	switch *version.State {
+	case projectv1.ProjectConfig_State_Validated:
+	case projectv1.ProjectConfig_State_ValidatingFailed:
+		if !force {
+			return nil, conflict("The validation of configuration %s failed, so its approval must be forced.", c.id)
+		}
+	default:
+		return nil, conflict("The configuration %s cannot be approved in state %s.", c.id, *version.State)
+	}
+
+	if c.approvedVersion != nil && c.approvedVersion != c.deployedVersion {
+		c.approvedVersion.State = core.StringPtr(projectv1.ProjectConfig_State_Superseded)
+	}
+	version.State = core.StringPtr(projectv1.ProjectConfig_State_Approved)
+	version.IsDraft = core.BoolPtr(false)
+	version.ModifiedAt = now()
+	version.LastApproved = &projectv1.ProjectConfigMetadataLastApproved{
+		At:       version.ModifiedAt,
+		Comment:  comment,
+		IsForced: core.BoolPtr(force),
+		UserID:   core.StringPtr("IBMid-projectv1fake"),
+	}
+	c.approvedVersion = version
+	return version, nil
+}
+
+// startJob starts a job of an action for a version of a configuration, and returns the version.
+func (server *Server) startJob(c *config, action string, version *projectv1.ProjectConfigVersion) *projectv1.ProjectConfigVersion {
+	c.job = &job{
+		id:       server.newID(),
+		action:   action,
+		version:  version,
+		finishAt: time.Now().Add(server.actionDelays[action]),
+		fail:     server.takeFailure(c.id, action),
+	}
+	version.State = core.StringPtr(jobStates[action][0])
+	version.ConfigError = nil
+	version.ModifiedAt = now()
+	return version
+}
+
+// takeFailure consumes a failure that was requested with FailAction.
+func (server *Server) takeFailure(configID string, action string) bool {
+	if server.actionFailures[configID][action] == 0 {
+		return false
+	}
+	server.actionFailures[configID][action]--
+	return true
+}
+
+// finishJobs finishes the jobs that have run for their delay.
+func (server *Server) finishJobs(at time.Time) {
+	for _, p := range server.projects {
+		for _, c := range p.configs {
+			if c.job != nil && !at.Before(c.job.finishAt) {
+				server.finishJob(c)
+			}
+		}
+	}
+}
+
+func (server *Server) finishJob(c *config) {
+	j := c.job
+	c.job = nil
+	version := j.version
+
+	result := projectv1.ActionJobWithIdAndSummary_Result_Succeeded
+	state := jobStates[j.action][1]
+	if j.fail {
+		result = projectv1.ActionJobWithIdAndSummary_Result_Failed
+		state = jobStates[j.action][2]
+		version.ConfigError = &projectv1.ProjectConfigError{
+			Message: core.StringPtr(fmt.Sprintf("The %s job %s failed.", j.action, j.id)),
+		}
+	}
+	version.State = core.StringPtr(state)
+	version.ModifiedAt = now()
+	lastAction := &projectv1.LastActionWithSummary{
+		Href:   server.versionHref(c, *version.Version),
+		Result: core.StringPtr(result),
+		Job: &projectv1.ActionJobWithIdAndSummary{
+			ID:     core.StringPtr(j.id),
+			Result: core.StringPtr(result),
+		},
+	}
+
+	switch j.action {
+	case ActionValidate:
+		version.LastValidated = &projectv1.LastValidatedActionWithSummary{
+			Href:   lastAction.Href,
+			Result: lastAction.Result,
+			Job:    lastAction.Job,
+		}
+		version.LastValidated.CraLogs = server.craLogs[c.id]
+		if !j.fail {
+			version.LastValidated.CostEstimate = server.costEstimates[c.id]
+		}
+	case ActionDeploy:
+		version.LastDeployed = lastAction
+		if !j.fail {
+			if c.deployedVersion != nil && c.deployedVersion != version {
+				c.deployedVersion.State = core.StringPtr(projectv1.ProjectConfig_State_Superseded)
+			}
+			c.deployedVersion = version
+			if outputs, found := server.deployOutputs[c.id]; found {
+				version.Outputs = outputs
+			}
+		}
+	case ActionUndeploy:
+		version.LastUndeployed = lastAction
+		if !j.fail {
+			c.deployedVersion = nil
+			version.Outputs = []projectv1.OutputValue{}
+		}
+	}
+}
+
+func (server *Server) validateConfig(req *http.Request, params []string) (interface{}, *apiError) {
+	c, apiErr := server.findIdleConfig(params[0], params[1])
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	version := c.current()
+	if !*version.IsDraft {
+		return nil, conflict("The configuration %s has no draft version to validate.", c.id)
+	}
+	return server.startJob(c, ActionValidate, version), nil
+}
+
+func (server *Server) deployConfig(req *http.Request, params []string) (interface{}, *apiError) {
+	c, apiErr := server.findIdleConfig(params[0], params[1])
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	if c.approvedVersion == nil {
+		return nil, conflict("The configuration %s has no approved version to deploy.", c.id)
+	}
+	return server.startJob(c, ActionDeploy, c.approvedVersion), nil
+}
+
+func (server *Server) undeployConfig(req *http.Request, params []string) (interface{}, *apiError) {
+	c, apiErr := server.findIdleConfig(params[0], params[1])
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	if c.deployedVersion == nil {
+		return nil, conflict("The configuration %s is not deployed.", c.id)
+	}
+	return server.startJob(c, ActionUndeploy, c.deployedVersion), nil
+}
+
+func (server *Server) syncConfig(req *http.Request, params []string) (interface{}, *apiError) {
+	_, apiErr := server.findConfig(params[0], params[1])
+	return nil, apiErr
+}
+
+func (server *Server) createPrevalidate(req *http.Request, params []string) (interface{}, *apiError) {
+	c, apiErr := server.findConfig(params[0], params[1])
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	resultID := server.newID()
+	c.prevalidations[resultID] = &prevalidation{
+		jobID:    server.newID(),
+		finishAt: time.Now().Add(server.actionDelays[ActionPrevalidate]),
+		fail:     server.takeFailure(c.id, ActionPrevalidate),
+	}
+	return &projectv1.Result{ResultID: core.StringPtr(resultID)}, nil
+}
+
+// getPrevalidate returns the job of a prevalidation, with its result once the job has finished.
+func (server *Server) getPrevalidate(req *http.Request, params []string) (interface{}, *apiError) {
+	c, apiErr := server.findConfig(params[0], params[1])
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	pv, found := c.prevalidations[params[2]]
+	if !found {
+		return nil, notFound("prevalidation result", params[2])
+	}
+	response := &projectv1.PrevalidateGetResponse{
+		Job: &projectv1.ActionJobWithIdAndSummary{ID: core.StringPtr(pv.jobID)},
+	}
+	if !time.Now().Before(pv.finishAt) {
+		result := projectv1.PrevalidateGetResponse_Result_Succeeded
+		if pv.fail {
+			result = projectv1.PrevalidateGetResponse_Result_Failed
+		}
+		response.Result = core.StringPtr(result)
+		response.Job.Result = core.StringPtr(result)
+		response.CraLogs = server.craLogs[c.id]
+		if !pv.fail {
+			response.CostEstimate = server.costEstimates[c.id]
+		}
+	}
+	return response, nil
+}
+
+// listConfigResources returns the resources of the resource CRNs in the definition of the deployed version.
+func (server *Server) listConfigResources(req *http.Request, params []string) (interface{}, *apiError) {
+	c, apiErr := server.findConfig(params[0], params[1])
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	resources := []projectv1.ProjectConfigResource{}
+	if c.deployedVersion != nil {
+		for _, crn := range definitionOf(c.deployedVersion).ResourceCrns {
+			resources = append(resources, projectv1.ProjectConfigResource{
+				ResourceCrn: core.StringPtr(crn),
+				Tags:        []string{},
+				CatalogTags: []string{},
+				ServiceTags: []string{},
+			})
+		}
+	}
+	return &projectv1.ProjectConfigResourceCollection{
+		Resources:      resources,
+		ResourcesCount: core.Int64Ptr(int64(len(resources))),
+	}, nil
+}
+
+func (server *Server) stackDefinitionModel(c *config, block *projectv1.StackDefinitionBlock) *projectv1.StackDefinition {
+	timestamp := now()
+	if c.stackDefinition == nil {
+		c.stackDefinition = &projectv1.StackDefinition{
+			ID:        core.StringPtr(server.newID()),
+			CreatedAt: timestamp,
+			Configuration: &projectv1.StackDefinitionMetadataConfiguration{
+				ID:         core.StringPtr(c.id),
+				Href:       server.configHref(c),
+				Definition: &projectv1.ConfigDefinitionReference{Name: definitionOf(c.current()).Name},
+			},
+			Href: server.href("/v1/projects/%s/configs/%s/stack_definition", c.project.id, c.id),
+		}
+	}
+	if len(block.Members) == 0 {
+		block.Members = server.stackDefinitionMembers(c)
+	}
+	c.stackDefinition.ModifiedAt = timestamp
+	c.stackDefinition.StackDefinition = block
+	return c.stackDefinition
+}
+
+// stackDefinitionMembers returns the members of the stack definition of a stack configuration, with the locators of
+// the member configurations.
+func (server *Server) stackDefinitionMembers(c *config) []projectv1.StackDefinitionMember {
+	members := []projectv1.StackDefinitionMember{}
+	for _, member := range definitionOf(c.current()).Members {
+		definitionMember := projectv1.StackDefinitionMember{
+			Name:   member.Name,
+			Inputs: []projectv1.StackDefinitionMemberInput{},
+		}
+		if memberConfig, apiErr := server.findConfig(c.project.id, *member.ConfigID); apiErr == nil {
+			definitionMember.VersionLocator = definitionOf(memberConfig.current()).LocatorID
+		}
+		members = append(members, definitionMember)
+	}
+	return members
+}
+
+func (server *Server) createStackDefinition(req *http.Request, params []string) (interface{}, *apiError) {
+	c, apiErr := server.findIdleConfig(params[0], params[1])
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	if *c.current().DeploymentModel != projectv1.ProjectConfig_DeploymentModel_Stack {
+		return nil, badRequest("The configuration %s is not a stack.", c.id)
+	}
+	if c.stackDefinition != nil {
+		return nil, conflict("The configuration %s already has a stack definition.", c.id)
+	}
+	body, apiErr := decodeBody(req)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	var block *projectv1.StackDefinitionBlock
+	if apiErr = unmarshalProperty(body, "stack_definition", &block, projectv1.UnmarshalStackDefinitionBlock); apiErr != nil {
+		return nil, apiErr
+	}
+	if block == nil {
+		return nil, badRequest("The stack_definition property is required.")
+	}
+	return server.stackDefinitionModel(c, block), nil
+}
+
+func (server *Server) getStackDefinition(req *http.Request, params []string) (interface{}, *apiError) {
+	c, apiErr := server.findConfig(params[0], params[1])
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	if c.stackDefinition == nil {
+		return nil, notFound("stack definition of configuration", c.id)
+	}
+	return c.stackDefinition, nil
+}
+
+func (server *Server) updateStackDefinition(req *http.Request, params []string) (interface{}, *apiError) {
+	c, apiErr := server.findIdleConfig(params[0], params[1])
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	if c.stackDefinition == nil {
+		return nil, notFound("stack definition of configuration", c.id)
+	}
+	body, apiErr := decodeBody(req)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	var block *projectv1.StackDefinitionBlock
+	if apiErr = patchModel(c.stackDefinition.StackDefinition, orNull(body["stack_definition"]), &block, projectv1.UnmarshalStackDefinitionBlock); apiErr != nil {
+		return nil, apiErr
+	}
+	return server.stackDefinitionModel(c, block), nil
+}
+
+// exportStackDefinition exports a stack definition as a new version of a catalog product.
+func (server *Server) exportStackDefinition(req *http.Request, params []string) (interface{}, *apiError) {
+	c, apiErr := server.findConfig(params[0], params[1])
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	if c.stackDefinition == nil {
+		return nil, notFound("stack definition of configuration", c.id)
+	}
+	body, apiErr := decodeBody(req)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	var settings struct {
+		CatalogID     *string  `json:"catalog_id"`
+		ProductID     *string  `json:"product_id"`
+		TargetVersion *string  `json:"target_version"`
+		Variation     *string  `json:"variation"`
+		Label         *string  `json:"label"`
+		Tags          []string `json:"tags"`
+	}
+	data, _ := json.Marshal(body)
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return nil, badRequest("The export settings are not valid: %s", err.Error())
+	}
+	if settings.CatalogID == nil {
+		return nil, badRequest("The catalog_id property is required.")
+	}
+	if settings.ProductID == nil {
+		settings.ProductID = core.StringPtr(server.newID())
+	}
+	if settings.Tags == nil {
+		settings.Tags = []string{}
+	}
+	return &projectv1.StackDefinitionExportResponse{
+		CatalogID:      settings.CatalogID,
+		TargetVersion:  settings.TargetVersion,
+		Variation:      settings.Variation,
+		ProductID:      settings.ProductID,
+		VersionLocator: core.StringPtr(*settings.CatalogID + "." + server.newID()),
+		Kind:           core.StringPtr("terraform"),
+		Format:         core.StringPtr("stack"),
+		Label:          settings.Label,
+		Tags:           settings.Tags,
+	}, nil
+}
+
+func (server *Server) listConfigVersions(req *http.Request, params []string) (interface{}, *apiError) {
+	c, apiErr := server.findConfig(params[0], params[1])
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	versions := []projectv1.ProjectConfigVersionSummary{}
+	for _, version := range c.versions {
+		versions = append(versions, *versionSummary(version))
+	}
+	return &projectv1.ProjectConfigVersionCollection{Versions: versions}, nil
+}
+
+func (server *Server) findConfigVersion(c *config, version string) (int, *apiError) {
+	number, err := strconv.ParseInt(version, 10, 64)
+	if err != nil {
+		return 0, badRequest("The version %s is not an integer.", version)
+	}
+	for i, v := range c.versions {
+		if *v.Version == number {
+			return i, nil
+		}
+	}
+	return 0, notFound("configuration version", version)
+}
+
+func (server *Server) getConfigVersion(req *http.Request, params []string) (interface{}, *apiError) {
+	c, apiErr := server.findConfig(params[0], params[1])
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	i, apiErr := server.findConfigVersion(c, params[2])
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	return c.versions[i], nil
+}
+
+func (server *Server) deleteConfigVersion(req *http.Request, params []string) (interface{}, *apiError) {
+	c, apiErr := server.findIdleConfig(params[0], params[1])
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	i, apiErr := server.findConfigVersion(c, params[2])
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	version := c.versions[i]
+	if len(c.versions) == 1 || version == c.approvedVersion || version == c.deployedVersion {
+		return nil, conflict("The version %s of configuration %s cannot be deleted.", params[2], c.id)
+	}
+	c.versions = append(c.versions[:i], c.versions[i+1:]...)
+	return &projectv1.ProjectConfigDelete{ID: core.StringPtr(c.id)}, nil
+}
@@ -0,0 +1,343 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/project-go-sdk/projectv1"
+	"github.com/go-openapi/strfmt"
+)
+
+// project is the state of a project.
+type project struct {
+	id            string
+	crn           string
+	createdAt     *strfmt.DateTime
+	location      string
+	resourceGroup string
+	definition    *projectv1.ProjectDefinition
+	environments  []*projectv1.Environment
+	configs       []*config
+}
+
+func (server *Server) findProject(id string) (*project, *apiError) {
+	for _, p := range server.projects {
+		if p.id == id {
+			return p, nil
+		}
+	}
+	return nil, notFound("project", id)
+}
+
+func (server *Server) projectHref(p *project) *string {
+	return server.href("/v1/projects/%s", p.id)
+}
+
+func (server *Server) projectReference(p *project) *projectv1.ProjectReference {
+	return &projectv1.ProjectReference{
+		ID:         core.StringPtr(p.id),
+		Href:       server.projectHref(p),
+		Definition: &projectv1.ProjectDefinitionReference{Name: p.definition.Name},
+		Crn:        core.StringPtr(p.crn),
+	}
+}
+
+func (server *Server) projectModel(p *project) *projectv1.Project {
+	configs := []projectv1.ProjectConfigSummary{}
+	for _, c := range p.configs {
+		configs = append(configs, *server.configSummary(c))
+	}
+	needsAttention := []projectv1.CumulativeNeedsAttention{}
+	for _, c := range p.configs {
+		for _, event := range server.needsAttention[c.id] {
+			needsAttention = append(needsAttention, projectv1.CumulativeNeedsAttention{
+				Event:         event.Event,
+				EventID:       event.EventID,
+				ConfigID:      core.StringPtr(c.id),
+				ConfigVersion: c.current().Version,
+			})
+		}
+	}
+	environments := []projectv1.ProjectEnvironmentSummary{}
+	for _, environment := range p.environments {
+		environments = append(environments, projectv1.ProjectEnvironmentSummary{
+			ID:        environment.ID,
+			Project:   environment.Project,
+			CreatedAt: environment.CreatedAt,
+			Href:      environment.Href,
+			Definition: &projectv1.ProjectEnvironmentSummaryDefinition{
+				Description: environment.Definition.Description,
+				Name:        environment.Definition.Name,
+			},
+		})
+	}
+	return &projectv1.Project{
+		Crn:                          core.StringPtr(p.crn),
+		CreatedAt:                    p.createdAt,
+		CumulativeNeedsAttentionView: needsAttention,
+		ID:                           core.StringPtr(p.id),
+		Location:                     core.StringPtr(p.location),
+		ResourceGroupID:              core.StringPtr(p.resourceGroup),
+		State:                        core.StringPtr(projectv1.Project_State_Ready),
+		Href:                         server.projectHref(p),
+		ResourceGroup:                core.StringPtr(p.resourceGroup),
+		Configs:                      configs,
+		Environments:                 environments,
+		Definition:                   p.definition,
+	}
+}
+
+func (server *Server) createProject(req *http.Request, params []string) (interface{}, *apiError) {
+	body, apiErr := decodeBody(req)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	p := &project{createdAt: now()}
+	if apiErr = unmarshalProperty(body, "definition", &p.definition, projectv1.UnmarshalProjectDefinition); apiErr != nil {
+		return nil, apiErr
+	}
+	if p.definition == nil || p.definition.Name == nil {
+		return nil, badRequest("The project definition must have a name.")
+	}
+	if json.Unmarshal(body["location"], &p.location) != nil || p.location == "" {
+		return nil, badRequest("The location of the project is required.")
+	}
+	if json.Unmarshal(body["resource_group"], &p.resourceGroup) != nil || p.resourceGroup == "" {
+		return nil, badRequest("The resource group of the project is required.")
+	}
+
+	var environments, configs []map[string]json.RawMessage
+	if json.Unmarshal(orNull(body["environments"]), &environments) != nil {
+		return nil, badRequest("The environments of the project must be an array.")
+	}
+	if json.Unmarshal(orNull(body["configs"]), &configs) != nil {
+		return nil, badRequest("The configs of the project must be an array.")
+	}
+
+	p.id = server.newID()
+	p.crn = fmt.Sprintf("crn:v1:bluemix:public:project:%s:a/projectv1fake::project:%s", p.location, p.id)
+	for _, environmentBody := range environments {
+		environment, apiErr := server.newEnvironment(p, environmentBody)
+		if apiErr != nil {
+			return nil, apiErr
+		}
+		p.environments = append(p.environments, environment)
+	}
+	for _, configBody := range configs {
+		c, apiErr := server.newConfig(p, configBody)
+		if apiErr != nil {
+			return nil, apiErr
+		}
+		p.configs = append(p.configs, c)
+	}
+	server.projects = append(server.projects, p)
+	return server.projectModel(p), nil
+}
+
+func (server *Server) listProjects(req *http.Request, params []string) (interface{}, *apiError) {
+	page, limit, first, next, apiErr := paginate(server, req, server.projects)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	projects := []projectv1.ProjectSummary{}
+	for _, p := range page {
+		model := server.projectModel(p)
+		projects = append(projects, projectv1.ProjectSummary{
+			Crn:                          model.Crn,
+			CreatedAt:                    model.CreatedAt,
+			CumulativeNeedsAttentionView: model.CumulativeNeedsAttentionView,
+			ID:                           model.ID,
+			Location:                     model.Location,
+			ResourceGroupID:              model.ResourceGroupID,
+			State:                        model.State,
+			Href:                         model.Href,
+			Definition: &projectv1.ProjectDefinitionSummary{
+				Name:            p.definition.Name,
+				Description:     p.definition.Description,
+				DestroyOnDelete: p.definition.DestroyOnDelete,
+			},
+		})
+	}
+	return &projectv1.ProjectCollection{
+		Limit:    core.Int64Ptr(limit),
+		First:    first,
+		Next:     next,
+		Projects: projects,
+	}, nil
+}
+
+func (server *Server) getProject(req *http.Request, params []string) (interface{}, *apiError) {
+	p, apiErr := server.findProject(params[0])
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	return server.projectModel(p), nil
+}
+
+func (server *Server) updateProject(req *http.Request, params []string) (interface{}, *apiError) {
+	p, apiErr := server.findProject(params[0])
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	body, apiErr := decodeBody(req)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	if patch, ok := body["definition"]; ok {
+		var definition *projectv1.ProjectDefinition
+		if apiErr = patchModel(p.definition, patch, &definition, projectv1.UnmarshalProjectDefinition); apiErr != nil {
+			return nil, apiErr
+		}
+		p.definition = definition
+	}
+	return server.projectModel(p), nil
+}
+
+func (server *Server) deleteProject(req *http.Request, params []string) (interface{}, *apiError) {
+	p, apiErr := server.findProject(params[0])
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	for i := range server.projects {
+		if server.projects[i] == p {
+			server.projects = append(server.projects[:i], server.projects[i+1:]...)
+			break
+		}
+	}
+	return &projectv1.ProjectDeleteResponse{ID: core.StringPtr(p.id)}, nil
+}
+
+func (server *Server) newEnvironment(p *project, body map[string]json.RawMessage) (*projectv1.Environment, *apiError) {
+	var definition *projectv1.EnvironmentDefinitionRequiredPropertiesResponse
+	if apiErr := unmarshalProperty(body, "definition", &definition, projectv1.UnmarshalEnvironmentDefinitionRequiredPropertiesResponse); apiErr != nil {
+		return nil, apiErr
+	}
+	if definition == nil || definition.Name == nil {
+		return nil, badRequest("The environment definition must have a name.")
+	}
+	id := server.newID()
+	timestamp := now()
+	return &projectv1.Environment{
+		ID:         core.StringPtr(id),
+		Project:    server.projectReference(p),
+		CreatedAt:  timestamp,
+		ModifiedAt: timestamp,
+		Href:       server.href("/v1/projects/%s/environments/%s", p.id, id),
+		Definition: definition,
+	}, nil
+}
+
+func (server *Server) findEnvironment(projectID string, id string) (*project, int, *apiError) {
+	p, apiErr := server.findProject(projectID)
+	if apiErr != nil {
+		return nil, 0, apiErr
+	}
+	for i, environment := range p.environments {
+		if *environment.ID == id {
+			return p, i, nil
+		}
+	}
+	return nil, 0, notFound("environment", id)
+}
+
+func (server *Server) createEnvironment(req *http.Request, params []string) (interface{}, *apiError) {
+	p, apiErr := server.findProject(params[0])
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	body, apiErr := decodeBody(req)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	environment, apiErr := server.newEnvironment(p, body)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	p.environments = append(p.environments, environment)
+	return environment, nil
+}
+
+func (server *Server) listEnvironments(req *http.Request, params []string) (interface{}, *apiError) {
+	p, apiErr := server.findProject(params[0])
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	page, limit, first, next, apiErr := paginate(server, req, p.environments)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	environments := []projectv1.Environment{}
+	for _, environment := range page {
+		environments = append(environments, *environment)
+	}
+	return &projectv1.EnvironmentCollection{
+		Limit:        core.Int64Ptr(limit),
+		First:        first,
+		Next:         next,
+		Environments: environments,
+	}, nil
+}
+
+func (server *Server) getEnvironment(req *http.Request, params []string) (interface{}, *apiError) {
+	p, i, apiErr := server.findEnvironment(params[0], params[1])
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	return p.environments[i], nil
+}
+
+func (server *Server) updateEnvironment(req *http.Request, params []string) (interface{}, *apiError) {
+	p, i, apiErr := server.findEnvironment(params[0], params[1])
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	body, apiErr := decodeBody(req)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	environment := p.environments[i]
+	if patch, ok := body["definition"]; ok {
+		var definition *projectv1.EnvironmentDefinitionRequiredPropertiesResponse
+		if apiErr = patchModel(environment.Definition, patch, &definition, projectv1.UnmarshalEnvironmentDefinitionRequiredPropertiesResponse); apiErr != nil {
+			return nil, apiErr
+		}
+		environment.Definition = definition
+		environment.ModifiedAt = now()
+	}
+	return environment, nil
+}
+
+func (server *Server) deleteEnvironment(req *http.Request, params []string) (interface{}, *apiError) {
+	p, i, apiErr := server.findEnvironment(params[0], params[1])
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	p.environments = append(p.environments[:i], p.environments[i+1:]...)
+	return &projectv1.EnvironmentDeleteResponse{ID: core.StringPtr(params[1])}, nil
+}
+
+// orNull returns the JSON null value for a missing property.
+func orNull(value json.RawMessage) json.RawMessage {
+	if value == nil {
+		return json.RawMessage("null")
+	}
+	return value
+}
@@ -0,0 +1,447 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package projectv1fake provides an in-process fake of the Projects service for tests.
+//
+// A Server keeps projects, environments, configurations, configuration versions and stack definitions in memory and
+// serves every route that projectv1.ProjectV1 calls. Configurations follow the validate, approve and deploy lifecycle
+// of the real service: validating, deploying and undeploying jobs finish after a configurable delay, and a job can be
+// made to fail to exercise the failure states.
+package projectv1fake
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/project-go-sdk/projectv1"
+	"github.com/go-openapi/strfmt"
+)
+
+// Constants for the jobs that the fake server runs for a configuration.
+const (
+	ActionDeploy      = "deploy"
+	ActionPrevalidate = "prevalidate"
+	ActionUndeploy    = "undeploy"
+	ActionValidate    = "validate"
+)
+
+// Constants for the page sizes of the list operations.
+const (
+	DefaultListLimit = 10
+	MaxListLimit     = 100
+)
+
+// Server : An in-memory implementation of the Projects service, served by an httptest.Server.
+// The zero value is not usable; create a Server with NewServer and close it with Close.
+type Server struct {
+	// The base URL of the server, which is used as the service URL of the clients.
+	URL string
+
+	httpServer *httptest.Server
+
+	mutex          sync.Mutex
+	lastID         int
+	projects       []*project
+	actionDelays   map[string]time.Duration
+	actionFailures map[string]map[string]int
+	deployOutputs  map[string][]projectv1.OutputValue
+	costEstimates  map[string]*projectv1.ProjectConfigMetadataCostEstimate
+	craLogs        map[string]*projectv1.ProjectConfigMetadataCodeRiskAnalyzerLogs
+	monitoring     map[string]*projectv1.LastMonitoringActionWithSummary
+	references     map[string]*projectv1.ReferenceValue
+	needsAttention map[string][]projectv1.ProjectConfigNeedsAttentionState
+	injectedErrors []*injectedError
+}
+
+// injectedError is an error response that is returned for the next request with a method and path.
+type injectedError struct {
+	method string
+	path   string
+	err    *apiError
+}
+
+// NewServer : Start a fake Projects service with no projects.
+func NewServer() *Server {
+	server := &Server{
+		actionDelays:   make(map[string]time.Duration),
+		actionFailures: make(map[string]map[string]int),
+		deployOutputs:  make(map[string][]projectv1.OutputValue),
+		costEstimates:  make(map[string]*projectv1.ProjectConfigMetadataCostEstimate),
+		craLogs:        make(map[string]*projectv1.ProjectConfigMetadataCodeRiskAnalyzerLogs),
+		monitoring:     make(map[string]*projectv1.LastMonitoringActionWithSummary),
+		references:     make(map[string]*projectv1.ReferenceValue),
+		needsAttention: make(map[string][]projectv1.ProjectConfigNeedsAttentionState),
+	}
+	server.httpServer = httptest.NewServer(server)
+	server.URL = server.httpServer.URL
+	return server
+}
+
+// Close : Shut down the server and block until all outstanding requests have completed.
+func (server *Server) Close() {
+	server.httpServer.Close()
+}
+
+// NewProjectV1 : Create a ProjectV1 client for the server.
+func (server *Server) NewProjectV1() (*projectv1.ProjectV1, error) {
+	return projectv1.NewProjectV1(&projectv1.ProjectV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+}
+
+// SetActionDelay : Set how long the jobs of an action run before they finish.
+// Jobs finish on the first request that is received after the delay, so with the default delay of zero a job is still
+// running in the response of the request that started it and has finished in the response of the next request.
+func (server *Server) SetActionDelay(action string, delay time.Duration) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	server.actionDelays[action] = delay
+}
+
+// FailAction : Make the next job of an action fail for a configuration.
+// Each call makes one more job fail.
+func (server *Server) FailAction(configID string, action string) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	if server.actionFailures[configID] == nil {
+		server.actionFailures[configID] = make(map[string]int)
+	}
+	server.actionFailures[configID][action]++
+}
+
+// SetDeployOutputs : Set the outputs that the deploy jobs of a configuration produce.
+// The outputs are set on the version when a deploy job succeeds, and removed when it is undeployed.
+func (server *Server) SetDeployOutputs(configID string, outputs []projectv1.OutputValue) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	server.deployOutputs[configID] = outputs
+}
+
+// SetCostEstimate : Set the cost estimate that the validation and prevalidation jobs of a configuration produce.
+// The estimate is set on the last validated action of the version when a validate job succeeds, and returned with the
+// result of a prevalidation that succeeds.
+func (server *Server) SetCostEstimate(configID string, estimate *projectv1.ProjectConfigMetadataCostEstimate) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	server.costEstimates[configID] = estimate
+}
+
+// SetCraLogs : Set the Code Risk Analyzer logs that the validation and prevalidation jobs of a configuration produce.
+// The logs are set on the last validated action of the version when a validate job finishes, and returned with the
+// result of a prevalidation that finishes, whether the job succeeds or fails.
+func (server *Server) SetCraLogs(configID string, logs *projectv1.ProjectConfigMetadataCodeRiskAnalyzerLogs) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	server.craLogs[configID] = logs
+}
+
+// SetLastMonitoring : Set the summary of the last monitoring action of a configuration.
+// The fake server does not run monitoring jobs; the summary is returned as the last_monitoring property of the
+// configuration, as if the service had run a drift detection job for it.
+func (server *Server) SetLastMonitoring(configID string, monitoring *projectv1.LastMonitoringActionWithSummary) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	server.monitoring[configID] = monitoring
+}
+
+// SetReferences : Set the resolved references of a configuration.
+// The fake server does not resolve references; the references are returned as the references property of the
+// configuration, as if the service had resolved the references of its inputs.
+func (server *Server) SetReferences(configID string, references *projectv1.ReferenceValue) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	server.references[configID] = references
+}
+
+// AddNeedsAttention : Add events to the needs attention state of a configuration.
+// The events are returned after the events of the version, in the order in which they are added, and are listed in
+// the cumulative needs attention view of the project.
+func (server *Server) AddNeedsAttention(configID string, events ...projectv1.ProjectConfigNeedsAttentionState) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	server.needsAttention[configID] = append(server.needsAttention[configID], events...)
+}
+
+// InjectError : Make the next request with a method and path fail with a status code and message.
+// The path is the URL path of the request, without the query.
+func (server *Server) InjectError(method string, path string, statusCode int, message string) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	server.injectedErrors = append(server.injectedErrors, &injectedError{
+		method: method,
+		path:   path,
+		err:    &apiError{statusCode: statusCode, code: "injected_error", message: message},
+	})
+}
+
+// ServeHTTP : Serve a request to the Projects service.
+func (server *Server) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	server.finishJobs(time.Now())
+
+	for i, injected := range server.injectedErrors {
+		if injected.method == req.Method && injected.path == req.URL.Path {
+			server.injectedErrors = append(server.injectedErrors[:i], server.injectedErrors[i+1:]...)
+			writeError(res, injected.err)
+			return
+		}
+	}
+
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	pathFound := false
+	for _, r := range routes {
+		params, matched := r.match(segments)
+		if !matched {
+			continue
+		}
+		pathFound = true
+		if r.method != req.Method {
+			continue
+		}
+		result, apiErr := r.handle(server, req, params)
+		if apiErr != nil {
+			writeError(res, apiErr)
+			return
+		}
+		if result == nil {
+			res.WriteHeader(r.statusCode)
+			return
+		}
+		res.Header().Set("Content-Type", "application/json")
+		res.WriteHeader(r.statusCode)
+		_ = json.NewEncoder(res).Encode(result)
+		return
+	}
+	if pathFound {
+		writeError(res, &apiError{statusCode: http.StatusMethodNotAllowed, code: "method_not_allowed", message: fmt.Sprintf("Method %s is not allowed for %s.", req.Method, req.URL.Path)})
+		return
+	}
+	writeError(res, notFound("route", req.URL.Path))
+}
+
+// route is an operation of the service. The "*" segments of the pattern match any path segment and are passed to the
+// handler as parameters, in order.
+type route struct {
+	method     string
+	pattern    string
+	statusCode int
+	handle     func(server *Server, req *http.Request, params []string) (interface{}, *apiError)
+}
+
+func (r route) match(segments []string) (params []string, matched bool) {
+	pattern := strings.Split(r.pattern, "/")
+	if len(pattern) != len(segments) {
+		return nil, false
+	}
+	for i, segment := range pattern {
+		if segment == "*" {
+			params = append(params, segments[i])
+		} else if segment != segments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+var routes = []route{
+	{"POST", "v1/projects", http.StatusCreated, (*Server).createProject},
+	{"GET", "v1/projects", http.StatusOK, (*Server).listProjects},
+	{"GET", "v1/projects/*", http.StatusOK, (*Server).getProject},
+	{"PATCH", "v1/projects/*", http.StatusOK, (*Server).updateProject},
+	{"DELETE", "v1/projects/*", http.StatusAccepted, (*Server).deleteProject},
+	{"POST", "v1/projects/*/environments", http.StatusCreated, (*Server).createEnvironment},
+	{"GET", "v1/projects/*/environments", http.StatusOK, (*Server).listEnvironments},
+	{"GET", "v1/projects/*/environments/*", http.StatusOK, (*Server).getEnvironment},
+	{"PATCH", "v1/projects/*/environments/*", http.StatusOK, (*Server).updateEnvironment},
+	{"DELETE", "v1/projects/*/environments/*", http.StatusOK, (*Server).deleteEnvironment},
+	{"POST", "v1/projects/*/configs", http.StatusCreated, (*Server).createConfig},
+	{"GET", "v1/projects/*/configs", http.StatusOK, (*Server).listConfigs},
+	{"GET", "v1/projects/*/configs/*", http.StatusOK, (*Server).getConfig},
+	{"PATCH", "v1/projects/*/configs/*", http.StatusOK, (*Server).updateConfig},
+	{"DELETE", "v1/projects/*/configs/*", http.StatusOK, (*Server).deleteConfig},
+	{"POST", "v1/projects/*/configs/*/force_approve", http.StatusCreated, (*Server).forceApprove},
+	{"POST", "v1/projects/*/configs/*/approve", http.StatusCreated, (*Server).approve},
+	{"POST", "v1/projects/*/configs/*/validate", http.StatusAccepted, (*Server).validateConfig},
+	{"POST", "v1/projects/*/configs/*/prevalidate", http.StatusAccepted, (*Server).createPrevalidate},
+	{"GET", "v1/projects/*/configs/*/prevalidate/*", http.StatusOK, (*Server).getPrevalidate},
+	{"POST", "v1/projects/*/configs/*/deploy", http.StatusAccepted, (*Server).deployConfig},
+	{"POST", "v1/projects/*/configs/*/undeploy", http.StatusAccepted, (*Server).undeployConfig},
+	{"POST", "v1/projects/*/configs/*/sync", http.StatusNoContent, (*Server).syncConfig},
+	{"GET", "v1/projects/*/configs/*/resources", http.StatusOK, (*Server).listConfigResources},
+	{"POST", "v1/projects/*/configs/*/stack_definition", http.StatusCreated, (*Server).createStackDefinition},
+	{"GET", "v1/projects/*/configs/*/stack_definition", http.StatusOK, (*Server).getStackDefinition},
+	{"PATCH", "v1/projects/*/configs/*/stack_definition", http.StatusOK, (*Server).updateStackDefinition},
+	{"POST", "v1/projects/*/configs/*/stack_definition/export", http.StatusOK, (*Server).exportStackDefinition},
+	{"GET", "v1/projects/*/configs/*/versions", http.StatusOK, (*Server).listConfigVersions},
+	{"GET", "v1/projects/*/configs/*/versions/*", http.StatusOK, (*Server).getConfigVersion},
+	{"DELETE", "v1/projects/*/configs/*/versions/*", http.StatusOK, (*Server).deleteConfigVersion},
+	{"DELETE", "v2/projects/*/configs/*/versions/*", http.StatusOK, (*Server).deleteConfigVersion},
+}
+
+// apiError is an error response in the format of the Projects service.
+type apiError struct {
+	statusCode int
+	code       string
+	message    string
+}
+
+func writeError(res http.ResponseWriter, apiErr *apiError) {
+	res.Header().Set("Content-Type", "application/json")
+	res.WriteHeader(apiErr.statusCode)
+	_ = json.NewEncoder(res).Encode(map[string]interface{}{
+		"errors": []map[string]string{
+			{"code": apiErr.code, "message": apiErr.message},
+		},
+		"status_code": apiErr.statusCode,
+		"trace":       "projectv1fake",
+	})
+}
+
+func badRequest(format string, a ...interface{}) *apiError {
+	return &apiError{statusCode: http.StatusBadRequest, code: "bad_request", message: fmt.Sprintf(format, a...)}
+}
+
+func notFound(kind string, id string) *apiError {
+	return &apiError{statusCode: http.StatusNotFound, code: "not_found", message: fmt.Sprintf("The %s %s was not found.", kind, id)}
+}
+
+func conflict(format string, a ...interface{}) *apiError {
+	return &apiError{statusCode: http.StatusConflict, code: "conflict", message: fmt.Sprintf(format, a...)}
+}
+
+// newID returns an identifier in the format of a UUID that is unique for the server.
+func (server *Server) newID() string {
+	server.lastID++
+	return fmt.Sprintf("00000000-0000-4000-8000-%012d", server.lastID)
+}
+
+func (server *Server) href(path string, a ...interface{}) *string {
+	return core.StringPtr(server.URL + fmt.Sprintf(path, a...))
+}
+
+func now() *strfmt.DateTime {
+	timestamp := strfmt.DateTime(time.Now().UTC())
+	return &timestamp
+}
+
+// decodeBody returns the top-level properties of the JSON body of a request.
+func decodeBody(req *http.Request) (map[string]json.RawMessage, *apiError) {
+	body := make(map[string]json.RawMessage)
+	if req.Body == nil {
+		return body, nil
+	}
+	err := json.NewDecoder(req.Body).Decode(&body)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, badRequest("The request body is not a JSON object: %s", err.Error())
+	}
+	if body == nil {
+		body = make(map[string]json.RawMessage)
+	}
+	return body, nil
+}
+
+// unmarshalProperty unmarshals a property of a request body with the unmarshaller of its model.
+func unmarshalProperty(body map[string]json.RawMessage, property string, result interface{}, unmarshaller core.ModelUnmarshaller) *apiError {
+	err := core.UnmarshalModel(body, property, result, unmarshaller)
+	if err != nil {
+		return badRequest("The %s property is not valid: %s", property, err.Error())
+	}
+	return nil
+}
+
+// patchModel applies the properties of a patch over the JSON representation of a model, and unmarshals the result
+// with the unmarshaller of the model.
+func patchModel(model interface{}, patch json.RawMessage, result interface{}, unmarshaller core.ModelUnmarshaller) *apiError {
+	var merged map[string]json.RawMessage
+	data, err := json.Marshal(model)
+	if err == nil {
+		err = json.Unmarshal(data, &merged)
+	}
+	if err != nil {
+		return &apiError{statusCode: http.StatusInternalServerError, code: "internal_error", message: err.Error()}
+	}
+	if merged == nil {
+		merged = make(map[string]json.RawMessage)
+	}
+
+	var properties map[string]json.RawMessage
+	if err := json.Unmarshal(patch, &properties); err != nil {
+		return badRequest("The patch is not a JSON object: %s", err.Error())
+	}
+	for name, value := range properties {
+		merged[name] = value
+	}
+	if err := core.UnmarshalModel(merged, "", result, unmarshaller); err != nil {
+		return badRequest("The patch is not valid: %s", err.Error())
+	}
+	return nil
+}
+
+// paginate returns the page of items selected by the limit and token query parameters of a request, with the
+// pagination links of the page. The token is the offset of the first item of the page.
+func paginate[T any](server *Server, req *http.Request, items []T) (page []T, limit int64, first *projectv1.PaginationLink, next *projectv1.PaginationLink, apiErr *apiError) {
+	query := req.URL.Query()
+	limit = DefaultListLimit
+	if value := query.Get("limit"); value != "" {
+		parsed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || parsed < 1 || parsed > MaxListLimit {
+			return nil, 0, nil, nil, badRequest("The limit must be an integer between 1 and %d.", MaxListLimit)
+		}
+		limit = parsed
+	}
+	offset := 0
+	if value := query.Get("token"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 0 {
+			return nil, 0, nil, nil, badRequest("The token %s is not valid.", value)
+		}
+		offset = parsed
+	}
+	if offset > len(items) {
+		offset = len(items)
+	}
+	end := offset + int(limit)
+	if end > len(items) {
+		end = len(items)
+	}
+
+	first = &projectv1.PaginationLink{Href: server.href("%s?limit=%d", req.URL.Path, limit)}
+	if end < len(items) {
+		next = &projectv1.PaginationLink{Href: server.href("%s?limit=%d&token=%d", req.URL.Path, limit, end)}
+	}
+	return items[offset:end], limit, first, next, nil
+}
@@ -0,0 +1,377 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1fake_test
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/project-go-sdk/projectv1"
+	"github.com/IBM/project-go-sdk/projectv1fake"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Server`, func() {
+	var server *projectv1fake.Server
+	var projectService *projectv1.ProjectV1
+
+	BeforeEach(func() {
+		server = projectv1fake.NewServer()
+		var serviceErr error
+		projectService, serviceErr = server.NewProjectV1()
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	createProject := func(name string) *projectv1.Project {
+		project, response, err := projectService.CreateProject(projectService.NewCreateProjectOptions(
+			&projectv1.ProjectPrototypeDefinition{Name: core.StringPtr(name)}, "us-south", "Default"))
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(201))
+		return project
+	}
+	createConfig := func(projectID string, name string) *projectv1.ProjectConfig {
+		config, _, err := projectService.CreateConfig(projectService.NewCreateConfigOptions(projectID,
+			&projectv1.ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype{
+				Name:      core.StringPtr(name),
+				LocatorID: core.StringPtr("1082e7d2-5e2f-0a11-a3bc-f88a8e1931fc.018edf04-e772-4ca2-9785-03e8e03bef72-global"),
+			}))
+		Expect(err).To(BeNil())
+		return config
+	}
+	waitForState := func(projectID string, configID string, state string) (*projectv1.ProjectConfig, error) {
+		config, _, err := projectService.WaitForConfigState(projectService.NewWaitForConfigStateOptions(projectID, configID, []string{state}).
+			SetPollInterval(time.Millisecond).
+			SetMaxPollInterval(time.Millisecond).
+			SetTimeout(5 * time.Second))
+		return config, err
+	}
+
+	Describe(`Projects`, func() {
+		It(`Creates, gets, updates and deletes a project`, func() {
+			project := createProject("my-project")
+			Expect(*project.ID).ToNot(BeEmpty())
+			Expect(*project.Location).To(Equal("us-south"))
+			Expect(*project.State).To(Equal(projectv1.Project_State_Ready))
+
+			updated, _, err := projectService.UpdateProject(projectService.NewUpdateProjectOptions(*project.ID,
+				&projectv1.ProjectDefinitionPatch{Description: core.StringPtr("A project")}))
+			Expect(err).To(BeNil())
+			Expect(*updated.Definition.Name).To(Equal("my-project"))
+			Expect(*updated.Definition.Description).To(Equal("A project"))
+
+			got, _, err := projectService.GetProject(projectService.NewGetProjectOptions(*project.ID))
+			Expect(err).To(BeNil())
+			Expect(*got.Definition.Description).To(Equal("A project"))
+
+			deleted, _, err := projectService.DeleteProject(projectService.NewDeleteProjectOptions(*project.ID))
+			Expect(err).To(BeNil())
+			Expect(*deleted.ID).To(Equal(*project.ID))
+
+			_, response, err := projectService.GetProject(projectService.NewGetProjectOptions(*project.ID))
+			Expect(err).ToNot(BeNil())
+			Expect(response.StatusCode).To(Equal(404))
+		})
+		It(`Lists projects in pages`, func() {
+			for _, name := range []string{"a", "b", "c", "d", "e"} {
+				createProject(name)
+			}
+
+			pager, err := projectService.NewProjectsPager(projectService.NewListProjectsOptions().SetLimit(2))
+			Expect(err).To(BeNil())
+			page, err := pager.GetNext()
+			Expect(err).To(BeNil())
+			Expect(page).To(HaveLen(2))
+
+			rest, err := pager.GetAll()
+			Expect(err).To(BeNil())
+			Expect(rest).To(HaveLen(3))
+			Expect(*rest[2].Definition.Name).To(Equal("e"))
+		})
+		It(`Rejects a project without a location`, func() {
+			_, response, err := projectService.CreateProject(projectService.NewCreateProjectOptions(
+				&projectv1.ProjectPrototypeDefinition{Name: core.StringPtr("my-project")}, "", "Default"))
+			Expect(err).ToNot(BeNil())
+			Expect(response.StatusCode).To(Equal(400))
+		})
+	})
+
+	Describe(`Environments`, func() {
+		It(`Creates, lists, updates and deletes environments`, func() {
+			project := createProject("my-project")
+
+			environment, _, err := projectService.CreateProjectEnvironment(projectService.NewCreateProjectEnvironmentOptions(*project.ID,
+				&projectv1.EnvironmentDefinitionRequiredProperties{Name: core.StringPtr("development")}))
+			Expect(err).To(BeNil())
+			Expect(*environment.Project.ID).To(Equal(*project.ID))
+
+			updated, _, err := projectService.UpdateProjectEnvironment(projectService.NewUpdateProjectEnvironmentOptions(*project.ID, *environment.ID,
+				&projectv1.EnvironmentDefinitionPropertiesPatch{Description: core.StringPtr("For development")}))
+			Expect(err).To(BeNil())
+			Expect(*updated.Definition.Name).To(Equal("development"))
+			Expect(*updated.Definition.Description).To(Equal("For development"))
+
+			environments, _, err := projectService.ListProjectEnvironments(projectService.NewListProjectEnvironmentsOptions(*project.ID))
+			Expect(err).To(BeNil())
+			Expect(environments.Environments).To(HaveLen(1))
+
+			got, _, err := projectService.GetProject(projectService.NewGetProjectOptions(*project.ID))
+			Expect(err).To(BeNil())
+			Expect(*got.Environments[0].Definition.Name).To(Equal("development"))
+
+			_, _, err = projectService.DeleteProjectEnvironment(projectService.NewDeleteProjectEnvironmentOptions(*project.ID, *environment.ID))
+			Expect(err).To(BeNil())
+			_, response, err := projectService.GetProjectEnvironment(projectService.NewGetProjectEnvironmentOptions(*project.ID, *environment.ID))
+			Expect(err).ToNot(BeNil())
+			Expect(response.StatusCode).To(Equal(404))
+		})
+	})
+
+	Describe(`Configurations`, func() {
+		var projectID string
+
+		BeforeEach(func() {
+			projectID = *createProject("my-project").ID
+		})
+
+		It(`Validates, approves and deploys a configuration`, func() {
+			config := createConfig(projectID, "my-config")
+			Expect(*config.State).To(Equal(projectv1.ProjectConfig_State_Draft))
+			Expect(*config.Version).To(Equal(int64(1)))
+			outputs := []projectv1.OutputValue{{Name: core.StringPtr("vpc_id"), Value: "r006-1234"}}
+			server.SetDeployOutputs(*config.ID, outputs)
+
+			validating, _, err := projectService.ValidateConfig(projectService.NewValidateConfigOptions(projectID, *config.ID))
+			Expect(err).To(BeNil())
+			Expect(*validating.State).To(Equal(projectv1.ProjectConfigVersion_State_Validating))
+
+			validated, err := waitForState(projectID, *config.ID, projectv1.ProjectConfig_State_Validated)
+			Expect(err).To(BeNil())
+			Expect(*validated.LastValidated.Result).To(Equal(projectv1.LastValidatedActionWithSummary_Result_Succeeded))
+
+			approved, _, err := projectService.Approve(projectService.NewApproveOptions(projectID, *config.ID).SetComment("Ship it"))
+			Expect(err).To(BeNil())
+			Expect(*approved.State).To(Equal(projectv1.ProjectConfigVersion_State_Approved))
+			Expect(*approved.LastApproved.Comment).To(Equal("Ship it"))
+
+			_, _, err = projectService.DeployConfig(projectService.NewDeployConfigOptions(projectID, *config.ID))
+			Expect(err).To(BeNil())
+			deployed, err := waitForState(projectID, *config.ID, projectv1.ProjectConfig_State_Deployed)
+			Expect(err).To(BeNil())
+			Expect(*deployed.DeployedVersion.Version).To(Equal(int64(1)))
+			Expect(*deployed.IsDraft).To(BeFalse())
+			Expect(deployed.Outputs).To(Equal(outputs))
+
+			_, _, err = projectService.UndeployConfig(projectService.NewUndeployConfigOptions(projectID, *config.ID))
+			Expect(err).To(BeNil())
+			undeployed, err := waitForState(projectID, *config.ID, projectv1.ProjectConfig_State_Approved)
+			Expect(err).To(BeNil())
+			Expect(undeployed.DeployedVersion).To(BeNil())
+			Expect(undeployed.Outputs).To(BeEmpty())
+		})
+		It(`Runs the configuration rollout against the fake`, func() {
+			config := createConfig(projectID, "my-config")
+
+			report, err := projectService.RolloutConfig(projectService.NewRolloutConfigOptions(projectID, *config.ID).
+				SetPollInterval(time.Millisecond).
+				SetMaxPollInterval(time.Millisecond))
+			Expect(err).To(BeNil())
+			Expect(*report.Config.State).To(Equal(projectv1.ProjectConfig_State_Deployed))
+		})
+		It(`Fails the jobs that are made to fail`, func() {
+			config := createConfig(projectID, "my-config")
+			server.FailAction(*config.ID, projectv1fake.ActionValidate)
+
+			_, _, err := projectService.ValidateConfig(projectService.NewValidateConfigOptions(projectID, *config.ID))
+			Expect(err).To(BeNil())
+			_, err = waitForState(projectID, *config.ID, projectv1.ProjectConfig_State_Validated)
+			var stateErr *projectv1.ConfigStateError
+			Expect(errors.As(err, &stateErr)).To(BeTrue())
+			Expect(stateErr.State()).To(Equal(projectv1.ProjectConfig_State_ValidatingFailed))
+			Expect(*stateErr.ConfigError.Message).To(ContainSubstring("validate job"))
+
+			_, response, err := projectService.Approve(projectService.NewApproveOptions(projectID, *config.ID))
+			Expect(err).ToNot(BeNil())
+			Expect(response.StatusCode).To(Equal(409))
+			_, response, err = projectService.ForceApprove(projectService.NewForceApproveOptions(projectID, *config.ID, ""))
+			Expect(err).ToNot(BeNil())
+			Expect(response.StatusCode).To(Equal(400))
+			forced, _, err := projectService.ForceApprove(projectService.NewForceApproveOptions(projectID, *config.ID, "Known issue"))
+			Expect(err).To(BeNil())
+			Expect(*forced.LastApproved.IsForced).To(BeTrue())
+
+			_, _, err = projectService.ValidateConfig(projectService.NewValidateConfigOptions(projectID, *config.ID))
+			Expect(err).ToNot(BeNil())
+		})
+		It(`Keeps jobs running for the action delay`, func() {
+			config := createConfig(projectID, "my-config")
+			server.SetActionDelay(projectv1fake.ActionValidate, time.Hour)
+
+			_, _, err := projectService.ValidateConfig(projectService.NewValidateConfigOptions(projectID, *config.ID))
+			Expect(err).To(BeNil())
+			got, _, err := projectService.GetConfig(projectService.NewGetConfigOptions(projectID, *config.ID))
+			Expect(err).To(BeNil())
+			Expect(*got.State).To(Equal(projectv1.ProjectConfig_State_Validating))
+
+			_, response, err := projectService.Approve(projectService.NewApproveOptions(projectID, *config.ID))
+			Expect(err).ToNot(BeNil())
+			Expect(response.StatusCode).To(Equal(409))
+			_, response, err = projectService.UpdateConfig(projectService.NewUpdateConfigOptions(projectID, *config.ID,
+				&projectv1.ProjectConfigDefinitionPatchDAConfigDefinitionPropertiesPatch{Description: core.StringPtr("changed")}))
+			Expect(err).ToNot(BeNil())
+			Expect(response.StatusCode).To(Equal(409))
+		})
+		It(`Creates a draft version when an approved configuration is updated`, func() {
+			config := createConfig(projectID, "my-config")
+			_, _, err := projectService.ValidateConfig(projectService.NewValidateConfigOptions(projectID, *config.ID))
+			Expect(err).To(BeNil())
+			_, err = waitForState(projectID, *config.ID, projectv1.ProjectConfig_State_Validated)
+			Expect(err).To(BeNil())
+			_, _, err = projectService.Approve(projectService.NewApproveOptions(projectID, *config.ID))
+			Expect(err).To(BeNil())
+
+			updated, _, err := projectService.UpdateConfig(projectService.NewUpdateConfigOptions(projectID, *config.ID,
+				&projectv1.ProjectConfigDefinitionPatchDAConfigDefinitionPropertiesPatch{
+					Inputs: map[string]interface{}{"region": "eu-de"},
+				}))
+			Expect(err).To(BeNil())
+			Expect(*updated.Version).To(Equal(int64(2)))
+			Expect(*updated.State).To(Equal(projectv1.ProjectConfig_State_Draft))
+			Expect(*updated.ApprovedVersion.Version).To(Equal(int64(1)))
+			definition := updated.Definition.(*projectv1.ProjectConfigDefinitionResponse)
+			Expect(*definition.Name).To(Equal("my-config"))
+			Expect(definition.Inputs).To(Equal(map[string]interface{}{"region": "eu-de"}))
+
+			versions, _, err := projectService.ListConfigVersions(projectService.NewListConfigVersionsOptions(projectID, *config.ID))
+			Expect(err).To(BeNil())
+			Expect(versions.Versions).To(HaveLen(2))
+			Expect(*versions.Versions[0].State).To(Equal(projectv1.ProjectConfigVersion_State_Approved))
+
+			version, _, err := projectService.GetConfigVersion(projectService.NewGetConfigVersionOptions(projectID, *config.ID, 1))
+			Expect(err).To(BeNil())
+			Expect(*version.IsDraft).To(BeFalse())
+
+			_, response, err := projectService.DeleteConfigVersion(projectService.NewDeleteConfigVersionOptions(projectID, *config.ID, 1))
+			Expect(err).ToNot(BeNil())
+			Expect(response.StatusCode).To(Equal(409))
+			_, _, err = projectService.DeleteConfigVersion(projectService.NewDeleteConfigVersionOptions(projectID, *config.ID, 2))
+			Expect(err).To(BeNil())
+			got, _, err := projectService.GetConfig(projectService.NewGetConfigOptions(projectID, *config.ID))
+			Expect(err).To(BeNil())
+			Expect(*got.Version).To(Equal(int64(1)))
+		})
+		It(`Lists and deletes configurations`, func() {
+			first := createConfig(projectID, "first")
+			createConfig(projectID, "second")
+
+			configs, _, err := projectService.ListConfigs(projectService.NewListConfigsOptions(projectID))
+			Expect(err).To(BeNil())
+			Expect(configs.Configs).To(HaveLen(2))
+			Expect(*configs.Configs[1].Definition.Name).To(Equal("second"))
+
+			_, _, err = projectService.DeleteConfig(projectService.NewDeleteConfigOptions(projectID, *first.ID))
+			Expect(err).To(BeNil())
+			configs, _, err = projectService.ListConfigs(projectService.NewListConfigsOptions(projectID))
+			Expect(err).To(BeNil())
+			Expect(configs.Configs).To(HaveLen(1))
+		})
+		It(`Reports prevalidation results once the job finishes`, func() {
+			config := createConfig(projectID, "my-config")
+			server.SetActionDelay(projectv1fake.ActionPrevalidate, time.Hour)
+
+			result, _, err := projectService.CreatePrevalidate(projectService.NewCreatePrevalidateOptions(projectID, *config.ID,
+				&projectv1.ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype{Name: core.StringPtr("my-config")}))
+			Expect(err).To(BeNil())
+			prevalidation, _, err := projectService.GetPrevalidate(projectService.NewGetPrevalidateOptions(projectID, *config.ID, *result.ResultID))
+			Expect(err).To(BeNil())
+			Expect(prevalidation.Result).To(BeNil())
+
+			server.SetActionDelay(projectv1fake.ActionPrevalidate, 0)
+			server.FailAction(*config.ID, projectv1fake.ActionPrevalidate)
+			result, _, err = projectService.CreatePrevalidate(projectService.NewCreatePrevalidateOptions(projectID, *config.ID,
+				&projectv1.ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype{Name: core.StringPtr("my-config")}))
+			Expect(err).To(BeNil())
+			prevalidation, _, err = projectService.GetPrevalidate(projectService.NewGetPrevalidateOptions(projectID, *config.ID, *result.ResultID))
+			Expect(err).To(BeNil())
+			Expect(*prevalidation.Result).To(Equal(projectv1.PrevalidateGetResponse_Result_Failed))
+		})
+	})
+
+	Describe(`Stack definitions`, func() {
+		It(`Creates, updates and exports a stack definition`, func() {
+			projectID := *createProject("my-project").ID
+			member := createConfig(projectID, "member")
+			stack, _, err := projectService.CreateConfig(projectService.NewCreateConfigOptions(projectID,
+				&projectv1.ProjectConfigDefinitionPrototypeDAConfigDefinitionPropertiesPrototype{
+					Name:    core.StringPtr("stack"),
+					Members: []projectv1.StackMember{{Name: core.StringPtr("member"), ConfigID: member.ID}},
+				}))
+			Expect(err).To(BeNil())
+			Expect(*stack.DeploymentModel).To(Equal(projectv1.ProjectConfig_DeploymentModel_Stack))
+
+			_, response, err := projectService.GetStackDefinition(projectService.NewGetStackDefinitionOptions(projectID, *stack.ID))
+			Expect(err).ToNot(BeNil())
+			Expect(response.StatusCode).To(Equal(404))
+
+			definition, _, err := projectService.CreateStackDefinition(projectService.NewCreateStackDefinitionOptions(projectID, *stack.ID,
+				&projectv1.StackDefinitionBlockPrototype{Inputs: []projectv1.StackDefinitionInputVariable{
+					{Name: core.StringPtr("region"), Type: core.StringPtr("string")},
+				}}))
+			Expect(err).To(BeNil())
+			Expect(*definition.Configuration.ID).To(Equal(*stack.ID))
+			Expect(definition.StackDefinition.Members).To(HaveLen(1))
+			Expect(*definition.StackDefinition.Members[0].VersionLocator).To(Equal(*member.Definition.(*projectv1.ProjectConfigDefinitionResponse).LocatorID))
+
+			updated, _, err := projectService.UpdateStackDefinition(projectService.NewUpdateStackDefinitionOptions(projectID, *stack.ID,
+				&projectv1.StackDefinitionBlockPrototype{Inputs: []projectv1.StackDefinitionInputVariable{
+					{Name: core.StringPtr("zone"), Type: core.StringPtr("string")},
+				}}))
+			Expect(err).To(BeNil())
+			Expect(updated.StackDefinition.Inputs).To(HaveLen(1))
+			Expect(*updated.StackDefinition.Inputs[0].Name).To(Equal("zone"))
+			Expect(updated.StackDefinition.Members).To(HaveLen(1))
+
+			exported, _, err := projectService.ExportStackDefinition(projectService.NewExportStackDefinitionOptions(projectID, *stack.ID,
+				&projectv1.StackDefinitionExportRequestStackDefinitionExportCatalogRequest{
+					CatalogID: core.StringPtr("my-catalog"),
+					Label:     core.StringPtr("My stack"),
+				}))
+			Expect(err).To(BeNil())
+			Expect(*exported.CatalogID).To(Equal("my-catalog"))
+			Expect(*exported.Label).To(Equal("My stack"))
+		})
+	})
+
+	Describe(`Injected errors`, func() {
+		It(`Fails the next matching request`, func() {
+			server.InjectError(http.MethodGet, "/v1/projects", http.StatusServiceUnavailable, "Try again later")
+
+			_, response, err := projectService.ListProjects(projectService.NewListProjectsOptions())
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("Try again later"))
+			Expect(response.StatusCode).To(Equal(503))
+
+			_, _, err = projectService.ListProjects(projectService.NewListProjectsOptions())
+			Expect(err).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,370 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package projectv1policy evaluates policy rules written in CEL, the Common Expression Language, against projects.
+//
+// Rules are evaluated against the JSON rendering of a projectv1.Manifest, so the same rules check a live project,
+// through projectv1.ProjectV1.ExportProject, and an exported manifest file, fully offline. Each rule targets the
+// project, each environment or each configuration, and its expression must evaluate to true for every target that
+// its condition selects. The expressions can use these variables, which hold the JSON objects of the manifest:
+//
+//   - project: the project, such as project.definition.auto_deploy.
+//   - environments: the list of environments.
+//   - configs: the list of configurations.
+//   - environment: the environment of an environment rule, or the environment of the configuration of a
+//     configuration rule. It is null for a configuration without an environment and for a project rule.
+//   - config: the configuration of a configuration rule, such as config.definition.compliance_profile. It is null for
+//     other rules.
+//
+// Properties that are not set are absent from the JSON objects, so test them with has() before reading them. The CEL
+// string extensions are available.
+//
+// The package is part of the SDK module, so that it is always built against the SDK models of the same version. Only
+// the programs that import it link CEL.
+package projectv1policy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/IBM/project-go-sdk/projectv1"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/ext"
+	"gopkg.in/yaml.v3"
+)
+
+// Constants associated with the Rule.Target property.
+// What the rule is evaluated against.
+const (
+	Rule_Target_Config      = "config"
+	Rule_Target_Environment = "environment"
+	Rule_Target_Project     = "project"
+)
+
+// Rule : A policy rule.
+type Rule struct {
+	// The name of the rule, which identifies it in violations.
+	Name string `json:"name" yaml:"name"`
+
+	// What the rule requires, which is reported with its violations.
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+
+	// What the rule is evaluated against. One of the Rule_Target_* values.
+	Target string `json:"target" yaml:"target"`
+
+	// A CEL expression that selects the targets that the rule applies to. The rule applies to every target if it is
+	// blank.
+	Condition string `json:"condition,omitempty" yaml:"condition,omitempty"`
+
+	// A CEL expression that must evaluate to true for every target that the rule applies to.
+	Expression string `json:"expression" yaml:"expression"`
+}
+
+// ParseRules : Parse YAML or JSON rules
+// The document is either a list of rules or an object with the list of rules in its rules property.
+func ParseRules(data []byte) (rules []Rule, err error) {
+	var document yaml.Node
+	if err = yaml.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("rules are not valid YAML or JSON: %w", err)
+	}
+	if len(document.Content) == 0 {
+		return nil, nil
+	}
+	node := document.Content[0]
+	if node.Kind == yaml.MappingNode {
+		var wrapper struct {
+			Rules []Rule `yaml:"rules"`
+		}
+		err = node.Decode(&wrapper)
+		rules = wrapper.Rules
+	} else {
+		err = node.Decode(&rules)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("rules are not valid: %w", err)
+	}
+	return rules, nil
+}
+
+// Violation : A target that does not comply with a rule.
+type Violation struct {
+	// The name of the rule.
+	Rule string `json:"rule"`
+
+	// The description of the rule.
+	Description string `json:"description,omitempty"`
+
+	// The kind of target. One of the Rule_Target_* values.
+	Target string `json:"target"`
+
+	// The name in the definition of the target.
+	Name string `json:"name"`
+
+	// The error of the rule expressions, if they could not be evaluated against the target. Such a target is
+	// reported as a violation, because it was not shown to comply.
+	Error string `json:"error,omitempty"`
+}
+
+// String returns the violation as a sentence, such as `config "vpc" violates "prod-compliance": every prod config
+// must have a compliance profile`.
+func (violation Violation) String() string {
+	text := fmt.Sprintf("%s %q violates %q", violation.Target, violation.Name, violation.Rule)
+	if violation.Description != "" {
+		text += ": " + violation.Description
+	}
+	if violation.Error != "" {
+		text += " (" + violation.Error + ")"
+	}
+	return text
+}
+
+// Report : The outcome of evaluating a policy.
+type Report struct {
+	// The violations, in the order of the rules and then of the targets in the manifest.
+	Violations []Violation `json:"violations"`
+}
+
+// Passed returns true if there are no violations.
+func (report *Report) Passed() bool {
+	return len(report.Violations) == 0
+}
+
+// String returns one line per violation.
+func (report *Report) String() string {
+	var builder strings.Builder
+	for _, violation := range report.Violations {
+		builder.WriteString(violation.String())
+		builder.WriteString("\n")
+	}
+	return builder.String()
+}
+
+// Policy : A set of compiled rules.
+type Policy struct {
+	rules []compiledRule
+}
+
+// compiledRule is a rule with its compiled expressions. The condition is nil if the rule applies to every target.
+type compiledRule struct {
+	Rule
+	condition  cel.Program
+	expression cel.Program
+}
+
+// NewPolicy : Compile rules into a policy
+// An error is returned if a rule has no name, an unknown target, or an expression that does not compile to a
+// boolean.
+func NewPolicy(rules ...Rule) (*Policy, error) {
+	env, err := cel.NewEnv(
+		cel.Variable("project", cel.DynType),
+		cel.Variable("environments", cel.ListType(cel.DynType)),
+		cel.Variable("configs", cel.ListType(cel.DynType)),
+		cel.Variable("environment", cel.DynType),
+		cel.Variable("config", cel.DynType),
+		ext.Strings(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	policy := &Policy{}
+	for _, rule := range rules {
+		if rule.Name == "" {
+			return nil, fmt.Errorf("a rule has no name")
+		}
+		switch rule.Target {
+		case Rule_Target_Config, Rule_Target_Environment, Rule_Target_Project:
+		default:
+			return nil, fmt.Errorf("rule %q has an unknown target %q", rule.Name, rule.Target)
+		}
+		compiled := compiledRule{Rule: rule}
+		if strings.TrimSpace(rule.Condition) != "" {
+			if compiled.condition, err = compileExpression(env, rule.Condition); err != nil {
+				return nil, fmt.Errorf("the condition of rule %q is not valid: %w", rule.Name, err)
+			}
+		}
+		if compiled.expression, err = compileExpression(env, rule.Expression); err != nil {
+			return nil, fmt.Errorf("the expression of rule %q is not valid: %w", rule.Name, err)
+		}
+		policy.rules = append(policy.rules, compiled)
+	}
+	return policy, nil
+}
+
+// compileExpression compiles a CEL expression that evaluates to a boolean.
+func compileExpression(env *cel.Env, expression string) (cel.Program, error) {
+	if strings.TrimSpace(expression) == "" {
+		return nil, fmt.Errorf("the expression is empty")
+	}
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if outputType := ast.OutputType(); outputType != cel.BoolType && outputType != cel.DynType {
+		return nil, fmt.Errorf("the expression evaluates to %s, not bool", outputType)
+	}
+	return env.Program(ast)
+}
+
+// Evaluate : Evaluate the policy against a manifest
+// The manifest can be parsed from a file with projectv1.ParseManifest, so no service is needed.
+func (policy *Policy) Evaluate(manifest *projectv1.Manifest) (*Report, error) {
+	if manifest == nil {
+		return nil, fmt.Errorf("manifest cannot be nil")
+	}
+	doc, err := newDocument(manifest)
+	if err != nil {
+		return nil, err
+	}
+	report := &Report{Violations: []Violation{}}
+	for _, rule := range policy.rules {
+		for _, target := range doc.targets(rule.Target) {
+			if violation, violated := rule.evaluate(target); violated {
+				report.Violations = append(report.Violations, violation)
+			}
+		}
+	}
+	return report, nil
+}
+
+// EvaluateProject : Evaluate the policy against a live project
+// The project is exported with ExportProjectWithContext and the policy is evaluated against the manifest.
+func (policy *Policy) EvaluateProject(ctx context.Context, service *projectv1.ProjectV1, projectID string) (*Report, error) {
+	manifest, err := service.ExportProjectWithContext(ctx, service.NewExportProjectOptions(projectID))
+	if err != nil {
+		return nil, err
+	}
+	return policy.Evaluate(manifest)
+}
+
+// evaluate evaluates a rule against a target. The second result is false if the target complies with the rule or the
+// rule does not apply to it.
+func (rule *compiledRule) evaluate(target target) (violation Violation, violated bool) {
+	violation = Violation{Rule: rule.Name, Description: rule.Description, Target: rule.Target, Name: target.name}
+	if rule.condition != nil {
+		applies, err := evalBool(rule.condition, target.variables)
+		if err != nil {
+			violation.Error = fmt.Sprintf("the condition could not be evaluated: %s", err.Error())
+			return violation, true
+		}
+		if !applies {
+			return violation, false
+		}
+	}
+	complies, err := evalBool(rule.expression, target.variables)
+	if err != nil {
+		violation.Error = fmt.Sprintf("the expression could not be evaluated: %s", err.Error())
+		return violation, true
+	}
+	return violation, !complies
+}
+
+// evalBool evaluates a program that returns a boolean.
+func evalBool(program cel.Program, variables map[string]interface{}) (bool, error) {
+	out, _, err := program.Eval(variables)
+	if err != nil {
+		return false, err
+	}
+	result, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("the result is %s, not bool", out.Type())
+	}
+	return result, nil
+}
+
+// document is the JSON rendering of a manifest.
+type document struct {
+	Project      map[string]interface{}   `json:"project"`
+	Environments []map[string]interface{} `json:"environments"`
+	Configs      []map[string]interface{} `json:"configs"`
+}
+
+// target is an object of a document that a rule is evaluated against, with the variables of the expressions.
+type target struct {
+	name      string
+	variables map[string]interface{}
+}
+
+func newDocument(manifest *projectv1.Manifest) (*document, error) {
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("the manifest cannot be encoded: %w", err)
+	}
+	doc := new(document)
+	if err = json.Unmarshal(data, doc); err != nil {
+		return nil, fmt.Errorf("the manifest cannot be decoded: %w", err)
+	}
+	if doc.Project == nil {
+		doc.Project = map[string]interface{}{}
+	}
+	return doc, nil
+}
+
+// targets returns the targets of a kind.
+func (doc *document) targets(kind string) (targets []target) {
+	environments := make([]interface{}, 0, len(doc.Environments))
+	environmentsByName := make(map[string]map[string]interface{})
+	for _, environment := range doc.Environments {
+		environments = append(environments, environment)
+		if name := definitionName(environment); name != "" {
+			environmentsByName[name] = environment
+		}
+	}
+	configs := make([]interface{}, 0, len(doc.Configs))
+	for _, config := range doc.Configs {
+		configs = append(configs, config)
+	}
+	variables := func(environment map[string]interface{}, config map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"project":      doc.Project,
+			"environments": environments,
+			"configs":      configs,
+			"environment":  nullable(environment),
+			"config":       nullable(config),
+		}
+	}
+
+	switch kind {
+	case Rule_Target_Project:
+		targets = append(targets, target{name: definitionName(doc.Project), variables: variables(nil, nil)})
+	case Rule_Target_Environment:
+		for _, environment := range doc.Environments {
+			targets = append(targets, target{name: definitionName(environment), variables: variables(environment, nil)})
+		}
+	case Rule_Target_Config:
+		for _, config := range doc.Configs {
+			environmentName, _ := config["environment"].(string)
+			targets = append(targets, target{name: definitionName(config), variables: variables(environmentsByName[environmentName], config)})
+		}
+	}
+	return targets
+}
+
+// nullable returns nil for a nil map, so that CEL sees null instead of an empty map.
+func nullable(object map[string]interface{}) interface{} {
+	if object == nil {
+		return nil
+	}
+	return object
+}
+
+// definitionName returns the name in the definition of an object.
+func definitionName(object map[string]interface{}) string {
+	definition, _ := object["definition"].(map[string]interface{})
+	name, _ := definition["name"].(string)
+	return name
+}
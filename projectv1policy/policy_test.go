@@ -0,0 +1,193 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projectv1policy_test
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/project-go-sdk/projectv1"
+	"github.com/IBM/project-go-sdk/projectv1fake"
+	"github.com/IBM/project-go-sdk/projectv1policy"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const testRulesYAML = `
+rules:
+  - name: prod-compliance
+    description: every prod config must have a compliance profile attached
+    target: config
+    condition: environment != null && environment.definition.name == "prod"
+    expression: has(config.definition.compliance_profile)
+  - name: safe-auto-deploy
+    description: auto_deploy must be false when destroy_on_delete is true
+    target: project
+    expression: >-
+      !(has(project.definition.destroy_on_delete) && project.definition.destroy_on_delete) ||
+      !(has(project.definition.auto_deploy) && project.definition.auto_deploy)
+  - name: described-environments
+    description: environments must be described
+    target: environment
+    expression: has(environment.definition.description) && environment.definition.description.trim() != ""
+`
+
+const testManifestYAML = `
+project:
+  definition:
+    name: my-project
+    destroy_on_delete: true
+    auto_deploy: true
+environments:
+  - definition:
+      name: prod
+      description: Production.
+  - definition:
+      name: dev
+configs:
+  - environment: prod
+    definition:
+      name: network
+      compliance_profile:
+        id: profile-id
+        profile_name: FS Cloud
+  - environment: prod
+    definition:
+      name: database
+  - environment: dev
+    definition:
+      name: sandbox
+  - definition:
+      name: shared
+`
+
+var _ = Describe(`Policy`, func() {
+	var policy *projectv1policy.Policy
+
+	BeforeEach(func() {
+		rules, err := projectv1policy.ParseRules([]byte(testRulesYAML))
+		Expect(err).To(BeNil())
+		Expect(rules).To(HaveLen(3))
+		policy, err = projectv1policy.NewPolicy(rules...)
+		Expect(err).To(BeNil())
+	})
+
+	It(`Evaluates rules against a manifest offline`, func() {
+		manifest, err := projectv1.ParseManifest([]byte(testManifestYAML))
+		Expect(err).To(BeNil())
+
+		report, err := policy.Evaluate(manifest)
+		Expect(err).To(BeNil())
+		Expect(report.Passed()).To(BeFalse())
+		Expect(report.Violations).To(Equal([]projectv1policy.Violation{
+			{
+				Rule:        "prod-compliance",
+				Description: "every prod config must have a compliance profile attached",
+				Target:      projectv1policy.Rule_Target_Config,
+				Name:        "database",
+			},
+			{
+				Rule:        "safe-auto-deploy",
+				Description: "auto_deploy must be false when destroy_on_delete is true",
+				Target:      projectv1policy.Rule_Target_Project,
+				Name:        "my-project",
+			},
+			{
+				Rule:        "described-environments",
+				Description: "environments must be described",
+				Target:      projectv1policy.Rule_Target_Environment,
+				Name:        "dev",
+			},
+		}))
+		Expect(report.String()).To(Equal(
+			`config "database" violates "prod-compliance": every prod config must have a compliance profile attached` + "\n" +
+				`project "my-project" violates "safe-auto-deploy": auto_deploy must be false when destroy_on_delete is true` + "\n" +
+				`environment "dev" violates "described-environments": environments must be described` + "\n"))
+
+		manifest.Project.Definition.AutoDeploy = core.BoolPtr(false)
+		manifest.Environments[1].Definition.Description = core.StringPtr("Development.")
+		manifest.Configs[1].Definition = &projectv1.ProjectConfigDefinitionPrototype{
+			Name:              core.StringPtr("database"),
+			ComplianceProfile: &projectv1.ProjectComplianceProfile{ID: core.StringPtr("profile-id")},
+		}
+		report, err = policy.Evaluate(manifest)
+		Expect(err).To(BeNil())
+		Expect(report.Passed()).To(BeTrue())
+		Expect(report.String()).To(BeEmpty())
+	})
+	It(`Evaluates rules against a live project`, func() {
+		server := projectv1fake.NewServer()
+		defer server.Close()
+		service, err := server.NewProjectV1()
+		Expect(err).To(BeNil())
+		project, _, err := service.CreateProject(service.NewCreateProjectOptions(&projectv1.ProjectPrototypeDefinition{
+			Name:            core.StringPtr("live-project"),
+			DestroyOnDelete: core.BoolPtr(true),
+			AutoDeploy:      core.BoolPtr(true),
+		}, "us-south", "Default"))
+		Expect(err).To(BeNil())
+
+		report, err := policy.EvaluateProject(context.Background(), service, *project.ID)
+		Expect(err).To(BeNil())
+		Expect(report.Violations).To(HaveLen(1))
+		Expect(report.Violations[0].Rule).To(Equal("safe-auto-deploy"))
+		Expect(report.Violations[0].Name).To(Equal("live-project"))
+
+		_, err = policy.EvaluateProject(context.Background(), service, "unknown")
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Reports the targets that a rule cannot be evaluated against`, func() {
+		policy, err := projectv1policy.NewPolicy(projectv1policy.Rule{
+			Name:       "prod-environment",
+			Target:     projectv1policy.Rule_Target_Config,
+			Expression: `environment.definition.name == "prod"`,
+		})
+		Expect(err).To(BeNil())
+		manifest, err := projectv1.ParseManifest([]byte(testManifestYAML))
+		Expect(err).To(BeNil())
+
+		report, err := policy.Evaluate(manifest)
+		Expect(err).To(BeNil())
+		Expect(report.Violations).To(HaveLen(2))
+		Expect(report.Violations[0].Name).To(Equal("sandbox"))
+		Expect(report.Violations[0].Error).To(BeEmpty())
+		Expect(report.Violations[1].Name).To(Equal("shared"))
+		Expect(report.Violations[1].Error).To(HavePrefix("the expression could not be evaluated: "))
+	})
+	It(`Rejects invalid rules`, func() {
+		_, err := projectv1policy.NewPolicy(projectv1policy.Rule{Target: projectv1policy.Rule_Target_Project, Expression: "true"})
+		Expect(err).To(MatchError("a rule has no name"))
+		_, err = projectv1policy.NewPolicy(projectv1policy.Rule{Name: "r", Target: "stack", Expression: "true"})
+		Expect(err).To(MatchError(`rule "r" has an unknown target "stack"`))
+		_, err = projectv1policy.NewPolicy(projectv1policy.Rule{Name: "r", Target: projectv1policy.Rule_Target_Project, Expression: "project.definition.name =="})
+		Expect(err.Error()).To(HavePrefix(`the expression of rule "r" is not valid: `))
+		_, err = projectv1policy.NewPolicy(projectv1policy.Rule{Name: "r", Target: projectv1policy.Rule_Target_Project, Expression: "size(configs)"})
+		Expect(err).To(MatchError(`the expression of rule "r" is not valid: the expression evaluates to int, not bool`))
+		_, err = projectv1policy.NewPolicy(projectv1policy.Rule{Name: "r", Target: projectv1policy.Rule_Target_Project, Condition: " ", Expression: "true"})
+		Expect(err).To(BeNil())
+		_, err = projectv1policy.NewPolicy(projectv1policy.Rule{Name: "r", Target: projectv1policy.Rule_Target_Project})
+		Expect(err).To(MatchError(`the expression of rule "r" is not valid: the expression is empty`))
+
+		rules, err := projectv1policy.ParseRules([]byte(`[{"name": "r", "target": "project", "expression": "true"}]`))
+		Expect(err).To(BeNil())
+		Expect(rules).To(Equal([]projectv1policy.Rule{{Name: "r", Target: "project", Expression: "true"}}))
+		_, err = projectv1policy.ParseRules([]byte(`rules: true`))
+		Expect(err).ToNot(BeNil())
+		_, err = policy.Evaluate(nil)
+		Expect(err).To(MatchError("manifest cannot be nil"))
+	})
+})